@@ -0,0 +1,58 @@
+package agenda
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Edit     key.Binding
+	Postpone key.Binding
+	Refresh  key.Binding
+	Back     key.Binding
+	ShowHelp key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e", "enter"),
+			key.WithHelp("e", "edit task"),
+		),
+		Postpone: key.NewBinding(
+			key.WithKeys(">"),
+			key.WithHelp(">", "postpone a day"),
+		),
+		Refresh: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "refresh"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "A"),
+			key.WithHelp("esc", "task list"),
+		),
+		ShowHelp: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
+	}
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Edit, k.Postpone, k.Back, k.ShowHelp}
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Edit, k.Postpone},
+		{k.Refresh, k.Back, k.ShowHelp},
+	}
+}
@@ -0,0 +1,252 @@
+package agenda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+// Model lists the open tasks with a due date in overdue, today, this week and
+// later sections.
+type Model struct {
+	client *api.Client
+	logger *logger.Logger
+	store  *store.Store
+
+	groups   []taskquery.AgendaGroup
+	areaMeta map[uint]store.FocusAreaMeta
+	taskMeta map[uint]store.TaskMeta
+	now      time.Time
+
+	// cursor indexes the tasks of all groups in order, and offset is the
+	// first line shown.
+	cursor int
+	offset int
+	status string
+
+	keys       keyMap
+	help       help.Model
+	helpBar    mouse.HelpBar
+	clicks     mouse.ClickTracker
+	zonePrefix string
+
+	height int
+	width  int
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	return Model{
+		client:     client,
+		logger:     logger,
+		store:      store,
+		keys:       newKeyMap(),
+		help:       help.New(),
+		helpBar:    mouse.NewHelpBar(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.Width = msg.Width
+		m.scrollToCursor()
+
+	case tea.KeyMsg:
+		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+	}
+
+	return m, nil
+}
+
+func (m Model) Blur() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+func (m Model) Focus() (tea.Model, tea.Cmd) {
+	m.status = ""
+
+	return m.refresh()
+}
+
+func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.status = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m, common.AppStateCmd(common.AppStateTaskList)
+
+	case key.Matches(msg, m.keys.Up):
+		m.moveCursor(-1)
+
+	case key.Matches(msg, m.keys.Down):
+		m.moveCursor(1)
+
+	case key.Matches(msg, m.keys.Edit):
+		return m.onEdit()
+
+	case key.Matches(msg, m.keys.Postpone):
+		return m.postpone()
+
+	case key.Matches(msg, m.keys.Refresh):
+		return m.refresh()
+
+	case key.Matches(msg, m.keys.ShowHelp):
+		m.help.ShowAll = !m.help.ShowAll
+		m.scrollToCursor()
+	}
+
+	return m, nil
+}
+
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if keyMsg, ok := m.helpBar.Clicked(m.keys, msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	switch {
+	case mouse.IsWheelUp(msg):
+		m.moveCursor(-1)
+
+	case mouse.IsWheelDown(msg):
+		m.moveCursor(1)
+
+	case mouse.IsLeftClick(msg):
+		for idx := range m.tasks() {
+			if zone.Get(m.taskZoneID(idx)).InBounds(msg) {
+				m.cursor = idx
+
+				if m.clicks.Click(fmt.Sprint(idx)) {
+					return m.onEdit()
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) refresh() (Model, tea.Cmd) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	tasks, err := m.client.ListTasks(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching tasks: %w", err))
+	}
+
+	m.taskMeta, err = m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	m.areaMeta, err = m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
+	m.now = time.Now()
+	m.groups = taskquery.GroupByDue(tasks, m.taskMeta, m.now)
+
+	m.cursor = max(0, min(m.cursor, len(m.tasks())-1))
+	m.scrollToCursor()
+
+	return m, nil
+}
+
+// tasks returns the tasks of all groups in the order they are shown.
+func (m Model) tasks() []soqapi.TaskDTO {
+	var tasks []soqapi.TaskDTO
+
+	for _, group := range m.groups {
+		tasks = append(tasks, group.Tasks...)
+	}
+
+	return tasks
+}
+
+func (m Model) selectedTask() (soqapi.TaskDTO, bool) {
+	tasks := m.tasks()
+	if m.cursor >= len(tasks) {
+		return soqapi.TaskDTO{}, false
+	}
+
+	return tasks[m.cursor], true
+}
+
+func (m *Model) selectTask(taskID uint) {
+	for idx, task := range m.tasks() {
+		if task.ID == taskID {
+			m.cursor = idx
+		}
+	}
+
+	m.scrollToCursor()
+}
+
+func (m *Model) moveCursor(delta int) {
+	m.cursor = max(0, min(m.cursor+delta, len(m.tasks())-1))
+	m.scrollToCursor()
+}
+
+func (m Model) onEdit() (Model, tea.Cmd) {
+	task, ok := m.selectedTask()
+	if !ok {
+		return m, nil
+	}
+
+	return m, tea.Sequence(
+		common.NewSelectTaskMsg(task),
+		common.AppStateCmd(common.AppStateTaskForm),
+	)
+}
+
+// postpone moves the due date of the selected task a day later.
+func (m Model) postpone() (Model, tea.Cmd) {
+	task, ok := m.selectedTask()
+	if !ok {
+		return m, nil
+	}
+
+	var postponed store.DueDate
+
+	err := m.store.UpdateTaskMeta(task.ID, func(meta *store.TaskMeta) {
+		if meta.Due == nil {
+			return
+		}
+
+		postponed = store.DueDate{At: meta.Due.At.AddDate(0, 0, 1), HasTime: meta.Due.HasTime}
+		meta.Due = &postponed
+	})
+	if err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	m, cmd := m.refresh()
+
+	m.selectTask(task.ID)
+	m.status = fmt.Sprintf("Postponed %q · %s", task.Summary, taskquery.DueLabel(postponed, m.now))
+
+	return m, cmd
+}
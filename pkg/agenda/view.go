@@ -0,0 +1,168 @@
+package agenda
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+var (
+	titleStyle = list.DefaultStyles().Title
+
+	sectionStyles = map[taskquery.AgendaBucket]lipgloss.Style{
+		taskquery.BucketOverdue:  lipgloss.NewStyle().Bold(true).Foreground(styles.ErrorRed),
+		taskquery.BucketToday:    lipgloss.NewStyle().Bold(true).Foreground(styles.Amber),
+		taskquery.BucketThisWeek: lipgloss.NewStyle().Bold(true).Foreground(styles.HotPink),
+		taskquery.BucketLater:    lipgloss.NewStyle().Bold(true).Foreground(styles.DarkGray),
+	}
+
+	taskStyle = lipgloss.NewStyle().Padding(0, 0, 0, 2)
+
+	selectedTaskStyle = lipgloss.NewStyle().
+				Foreground(styles.HotPink).
+				Border(lipgloss.NormalBorder(), false, false, false, true).
+				BorderForeground(styles.HotPink).
+				Padding(0, 0, 0, 1)
+
+	detailStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+
+	overdueStyle = lipgloss.NewStyle().Foreground(styles.ErrorRed)
+
+	emptyStyle = lipgloss.NewStyle().
+			Foreground(styles.DarkGray).
+			Italic(true)
+
+	statusStyle = lipgloss.NewStyle().Foreground(styles.Amber)
+)
+
+func (m Model) View() string {
+	help := m.renderHelp()
+	header := lipgloss.NewStyle().PaddingBottom(1).Render(titleStyle.Render("Agenda"))
+
+	bodyHeight := m.bodyHeight()
+
+	body := emptyStyle.Render("No open tasks have a due date. Set one when editing a task.")
+	if len(m.groups) > 0 {
+		lines := m.renderLines()
+		end := min(len(lines), m.offset+bodyHeight)
+		body = strings.Join(lines[m.offset:end], "\n")
+	}
+
+	body = lipgloss.NewStyle().Height(bodyHeight).MaxHeight(bodyHeight).Render(body)
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, help)
+}
+
+func (m Model) bodyHeight() int {
+	// The title takes two lines.
+	return max(1, m.height-2-lipgloss.Height(m.renderHelp()))
+}
+
+// renderLines renders every section, one line per header and task, with a
+// blank line between sections.
+func (m Model) renderLines() []string {
+	var lines []string
+
+	taskIdx := 0
+
+	for groupIdx, group := range m.groups {
+		if groupIdx > 0 {
+			lines = append(lines, "")
+		}
+
+		lines = append(lines, sectionStyles[group.Bucket].Render(fmt.Sprintf("%s (%d)", group.Bucket, len(group.Tasks))))
+
+		for _, task := range group.Tasks {
+			lines = append(lines, m.renderTask(task, group.Bucket, taskIdx == m.cursor, taskIdx))
+			taskIdx++
+		}
+	}
+
+	return lines
+}
+
+func (m Model) renderTask(task soqapi.TaskDTO, bucket taskquery.AgendaBucket, selected bool, idx int) string {
+	due := m.taskMeta[task.ID].Due
+
+	dueStyle := detailStyle
+	if bucket == taskquery.BucketOverdue {
+		dueStyle = overdueStyle
+	}
+
+	meta := m.areaMeta[task.FocusArea.ID]
+	details := []string{
+		dueStyle.Render(taskquery.DueLabel(*due, m.now)),
+		styles.FocusAreaBadge(task.FocusArea.Name, meta.Glyph, meta.Color),
+	}
+
+	if priority := m.taskMeta[task.ID].Priority; priority != store.PriorityNone {
+		details = append(details, detailStyle.Render(priority.Label()))
+	}
+
+	style := taskStyle
+	if selected {
+		style = selectedTaskStyle
+	}
+
+	line := style.Render(task.Summary) + "  " + strings.Join(details, detailStyle.Render(" · "))
+
+	return zone.Mark(m.taskZoneID(idx), lipgloss.NewStyle().MaxWidth(m.width).Render(line))
+}
+
+// lineOf returns the line of the task at idx in renderLines.
+func (m Model) lineOf(idx int) int {
+	line := 0
+
+	for groupIdx, group := range m.groups {
+		if groupIdx > 0 {
+			line++
+		}
+
+		line++
+
+		if idx < len(group.Tasks) {
+			return line + idx
+		}
+
+		idx -= len(group.Tasks)
+		line += len(group.Tasks)
+	}
+
+	return line
+}
+
+// scrollToCursor keeps the selected task in view, and its section header too
+// when there is room.
+func (m *Model) scrollToCursor() {
+	height := m.bodyHeight()
+	line := m.lineOf(m.cursor)
+
+	if line-1 < m.offset {
+		m.offset = max(0, line-1)
+	}
+
+	if line >= m.offset+height {
+		m.offset = line - height + 1
+	}
+}
+
+func (m Model) renderHelp() string {
+	help := m.helpBar.View(m.help, m.keys)
+
+	if m.status == "" {
+		return help
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, statusStyle.Render(m.status), help)
+}
+
+func (m Model) taskZoneID(idx int) string {
+	return fmt.Sprintf("%stask-%d", m.zonePrefix, idx)
+}
@@ -6,16 +6,26 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/agenda"
 	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/board"
 	"github.com/mole-squad/soq-tui/pkg/common"
 	"github.com/mole-squad/soq-tui/pkg/focusareaform"
 	"github.com/mole-squad/soq-tui/pkg/focusarealist"
+	"github.com/mole-squad/soq-tui/pkg/focustimer"
 	"github.com/mole-squad/soq-tui/pkg/logger"
 	"github.com/mole-squad/soq-tui/pkg/loginform"
+	"github.com/mole-squad/soq-tui/pkg/reminders"
+	"github.com/mole-squad/soq-tui/pkg/review"
 	"github.com/mole-squad/soq-tui/pkg/settings"
+	"github.com/mole-squad/soq-tui/pkg/store"
 	"github.com/mole-squad/soq-tui/pkg/styles"
 	"github.com/mole-squad/soq-tui/pkg/taskform"
 	"github.com/mole-squad/soq-tui/pkg/tasklist"
+	"github.com/mole-squad/soq-tui/pkg/timeentryform"
+	"github.com/mole-squad/soq-tui/pkg/timelog"
+	"github.com/mole-squad/soq-tui/pkg/timerbar"
 	"github.com/mole-squad/soq-tui/pkg/utils"
 )
 
@@ -27,6 +37,7 @@ type Model struct {
 	client *api.Client
 
 	logger *logger.Logger
+	store  *store.Store
 
 	configDir string
 	debug     bool
@@ -34,12 +45,15 @@ type Model struct {
 	appState common.AppState
 	error
 
-	views map[common.AppState]common.AppView
+	views     map[common.AppState]common.AppView
+	timerBar  timerbar.Model
+	reminders reminders.Model
 
 	keys keyMap
 
-	quitting bool
-	width    int
+	quitting   bool
+	width      int
+	windowSize tea.WindowSizeMsg
 }
 
 type AppModelOption func(*Model)
@@ -55,8 +69,11 @@ func New(opts ...AppModelOption) Model {
 		opt(&model)
 	}
 
+	zone.NewGlobal()
+
 	model.logger = logger.New(model.debug)
 	model.client = api.NewClient(model.logger, model.configDir)
+	model.store = store.New(model.configDir)
 
 	err := model.client.LoadToken()
 	if err != nil {
@@ -67,15 +84,25 @@ func New(opts ...AppModelOption) Model {
 		common.AppStateLoading: NewLoadingModel(),
 		common.AppStateLogin:   loginform.New(model.logger, model.client),
 
-		common.AppStateFocusAreaList: focusarealist.New(model.logger, model.client),
-		common.AppStateFocusAreaForm: focusareaform.New(model.logger, model.client),
+		common.AppStateFocusAreaList: focusarealist.New(model.logger, model.client, model.store),
+		common.AppStateFocusAreaForm: focusareaform.New(model.logger, model.client, model.store),
+
+		common.AppStateTaskList: tasklist.New(model.logger, model.client, model.store),
+		common.AppStateTaskForm: taskform.New(model.logger, model.client, model.store),
+		common.AppStateBoard:    board.New(model.logger, model.client, model.store),
+		common.AppStateAgenda:   agenda.New(model.logger, model.client, model.store),
+		common.AppStateReview:   review.New(model.logger, model.client, model.store),
 
-		common.AppStateTaskList: tasklist.New(model.logger, model.client),
-		common.AppStateTaskForm: taskform.New(model.logger, model.client),
+		common.AppStateFocusTimer:    focustimer.New(model.logger, model.client, model.store),
+		common.AppStateTimeLog:       timelog.New(model.logger, model.client, model.store),
+		common.AppStateTimeEntryForm: timeentryform.New(model.logger, model.client, model.store),
 
-		common.AppStateSettings: settings.New(model.logger, model.client),
+		common.AppStateSettings: settings.New(model.logger, model.client, model.store),
 	}
 
+	model.timerBar = timerbar.New(model.logger, model.store)
+	model.reminders = reminders.New(model.logger, model.client, model.store)
+
 	return model
 }
 
@@ -86,6 +113,9 @@ func (m Model) Init() tea.Cmd {
 		cmds = utils.AppendIfNotNil(cmds, view.Init())
 	}
 
+	cmds = utils.AppendIfNotNil(cmds, m.timerBar.Init())
+	cmds = utils.AppendIfNotNil(cmds, m.reminders.Init())
+
 	initCmd := utils.BatchIfNotNil(cmds...)
 
 	navCmd := common.AppStateCmd(common.AppStateLogin)
@@ -121,15 +151,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.onKeyMsg(msg)
 
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+
 	case common.AppStateMsg:
 		return m.onAppStateMsg(msg)
 	}
 
-	return m.applyUpdates(msg)
+	return m.onBroadcastMsg(msg)
 }
 
 func (m Model) View() string {
-	return styles.PageWrapperStyle.Render(m.renderContent())
+	return zone.Scan(styles.PageWrapperStyle.Render(m.renderContent()))
 }
 
 func (m Model) renderContent() string {
@@ -141,7 +174,32 @@ func (m Model) renderContent() string {
 		return errorStyle.Width(m.width).Render(fmt.Sprintf("Error: %s\n", m.error))
 	}
 
-	return m.views[m.appState].View()
+	content := m.views[m.appState].View()
+
+	if footer := m.renderFooter(); footer != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, content, footer)
+	}
+
+	return content
+}
+
+// renderFooter stacks the reminder and timer bars that are showing.
+func (m Model) renderFooter() string {
+	var bars []string
+
+	if m.reminders.IsVisible() {
+		bars = append(bars, m.reminders.View())
+	}
+
+	if m.timerBar.IsVisible() {
+		bars = append(bars, m.timerBar.View())
+	}
+
+	if len(bars) == 0 {
+		return ""
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, bars...)
 }
 
 func (m Model) onAppStateMsg(msg common.AppStateMsg) (tea.Model, tea.Cmd) {
@@ -161,7 +219,37 @@ func (m Model) onAppStateMsg(msg common.AppStateMsg) (tea.Model, tea.Cmd) {
 	return m, utils.SequenceIfNotNil(blurCmd, focusCmd)
 }
 
-func (m Model) applyUpdates(msg tea.Msg) (tea.Model, tea.Cmd) {
+// onBroadcastMsg passes a message to every view and the footer bars. The
+// views are resized when a bar appears or disappears.
+func (m Model) onBroadcastMsg(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var barCmd, reminderCmd tea.Cmd
+
+	footerHeight := m.footerHeight()
+	m.timerBar, barCmd = m.timerBar.Update(msg)
+	m.reminders, reminderCmd = m.reminders.Update(msg)
+
+	m, cmd := m.applyUpdates(msg)
+
+	if m.footerHeight() != footerHeight {
+		var resizeCmd tea.Cmd
+
+		m, resizeCmd = m.onWindowSizeMsg(m.windowSize)
+		cmd = utils.BatchIfNotNil(cmd, resizeCmd)
+	}
+
+	return m, utils.BatchIfNotNil(barCmd, reminderCmd, cmd)
+}
+
+func (m Model) footerHeight() int {
+	footer := m.renderFooter()
+	if footer == "" {
+		return 0
+	}
+
+	return lipgloss.Height(footer)
+}
+
+func (m Model) applyUpdates(msg tea.Msg) (Model, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0)
 
 	for key, view := range m.views {
@@ -171,14 +259,17 @@ func (m Model) applyUpdates(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, utils.BatchIfNotNil(cmds...)
 }
 
-func (m Model) onWindowSizeMsg(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
+func (m Model) onWindowSizeMsg(msg tea.WindowSizeMsg) (Model, tea.Cmd) {
 	docFrameWidth, docFrameHeight := styles.PageWrapperStyle.GetFrameSize()
 
 	m.width = msg.Width
+	m.windowSize = msg
+	m.timerBar = m.timerBar.SetWidth(msg.Width - docFrameWidth)
+	m.reminders = m.reminders.SetWidth(msg.Width - docFrameWidth)
 
 	wrappedMsg := tea.WindowSizeMsg{
 		Width:  msg.Width - docFrameWidth,
-		Height: msg.Height - docFrameHeight,
+		Height: msg.Height - docFrameHeight - m.footerHeight(),
 	}
 
 	return m.applyUpdates(wrappedMsg)
@@ -196,6 +287,13 @@ func (m Model) onKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+func (m Model) onMouseMsg(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	updatedView, cmd := m.views[m.appState].Update(msg)
+	m.views[m.appState] = updatedView.(common.AppView)
+
+	return m, cmd
+}
+
 func WithConfigDir(dir string) AppModelOption {
 	return func(m *Model) {
 		m.configDir = dir
@@ -0,0 +1,68 @@
+package board
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Left      key.Binding
+	Right     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	MoveLeft  key.Binding
+	MoveRight key.Binding
+	Refresh   key.Binding
+	Back      key.Binding
+	ShowHelp  key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Left: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "previous column"),
+		),
+		Right: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "next column"),
+		),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		MoveLeft: key.NewBinding(
+			key.WithKeys("shift+left", "H", "<"),
+			key.WithHelp("H", "move card left"),
+		),
+		MoveRight: key.NewBinding(
+			key.WithKeys("shift+right", "L", ">"),
+			key.WithHelp("L", "move card right"),
+		),
+		Refresh: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "refresh"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "B"),
+			key.WithHelp("esc", "task list"),
+		),
+		ShowHelp: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
+	}
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Left, k.Right, k.MoveLeft, k.MoveRight, k.Back, k.ShowHelp}
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Left, k.Right, k.Up, k.Down},
+		{k.MoveLeft, k.MoveRight},
+		{k.Refresh, k.Back, k.ShowHelp},
+	}
+}
@@ -0,0 +1,354 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/taskops"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+// column is a focus area and its open tasks.
+type column struct {
+	focusArea soqapi.FocusAreaDTO
+	tasks     []soqapi.TaskDTO
+}
+
+// Model shows open tasks as cards in one column per focus area.
+type Model struct {
+	client *api.Client
+	logger *logger.Logger
+	store  *store.Store
+
+	columns  []column
+	areaMeta map[uint]store.FocusAreaMeta
+	taskMeta map[uint]store.TaskMeta
+
+	// col is the selected column and offset the first one shown. cursors
+	// holds the selected card of each column by focus area ID.
+	col     int
+	offset  int
+	cursors map[uint]int
+	status  string
+
+	keys       keyMap
+	help       help.Model
+	helpBar    mouse.HelpBar
+	zonePrefix string
+
+	height int
+	width  int
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	return Model{
+		client:     client,
+		logger:     logger,
+		store:      store,
+		cursors:    make(map[uint]int),
+		keys:       newKeyMap(),
+		help:       help.New(),
+		helpBar:    mouse.NewHelpBar(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.Width = msg.Width
+		m.scrollToColumn()
+
+	case tea.KeyMsg:
+		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+	}
+
+	return m, nil
+}
+
+func (m Model) Blur() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+func (m Model) Focus() (tea.Model, tea.Cmd) {
+	m.status = ""
+
+	return m.refresh()
+}
+
+func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.status = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m, common.AppStateCmd(common.AppStateTaskList)
+
+	case key.Matches(msg, m.keys.Left):
+		m.selectColumn(m.col - 1)
+
+	case key.Matches(msg, m.keys.Right):
+		m.selectColumn(m.col + 1)
+
+	case key.Matches(msg, m.keys.Up):
+		m.moveCursor(-1)
+
+	case key.Matches(msg, m.keys.Down):
+		m.moveCursor(1)
+
+	case key.Matches(msg, m.keys.MoveLeft):
+		return m.moveCard(-1)
+
+	case key.Matches(msg, m.keys.MoveRight):
+		return m.moveCard(1)
+
+	case key.Matches(msg, m.keys.Refresh):
+		return m.refresh()
+
+	case key.Matches(msg, m.keys.ShowHelp):
+		m.help.ShowAll = !m.help.ShowAll
+	}
+
+	return m, nil
+}
+
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if keyMsg, ok := m.helpBar.Clicked(m.keys, msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	switch {
+	case mouse.IsWheelUp(msg):
+		m.moveCursor(-1)
+
+	case mouse.IsWheelDown(msg):
+		m.moveCursor(1)
+
+	case mouse.IsLeftClick(msg):
+		m.onClick(msg)
+	}
+
+	return m, nil
+}
+
+// onClick selects the card or column header under the pointer.
+func (m *Model) onClick(msg tea.MouseMsg) {
+	for colIdx, col := range m.columns {
+		if zone.Get(m.columnZoneID(colIdx)).InBounds(msg) {
+			m.selectColumn(colIdx)
+		}
+
+		for cardIdx := range col.tasks {
+			if zone.Get(m.cardZoneID(colIdx, cardIdx)).InBounds(msg) {
+				m.selectColumn(colIdx)
+				m.cursors[col.focusArea.ID] = cardIdx
+
+				return
+			}
+		}
+	}
+}
+
+func (m Model) refresh() (Model, tea.Cmd) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	focusAreas, err := m.client.ListFocusAreas(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching focus areas: %w", err))
+	}
+
+	tasks, err := m.client.ListTasks(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching tasks: %w", err))
+	}
+
+	m.areaMeta, err = m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
+	m.taskMeta, err = m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	// Snoozed tasks stay off the board unless the task list shows them.
+	if !prefs.ShowSnoozed {
+		tasks = taskquery.WithoutSnoozed(tasks, m.taskMeta, time.Now())
+	}
+
+	tasks = taskquery.SortTasks(tasks, sortOrder(prefs), m.taskMeta, m.taskOrder())
+
+	m.columns = make([]column, len(focusAreas))
+	for idx, fa := range focusAreas {
+		m.columns[idx] = column{
+			focusArea: fa,
+			tasks:     taskquery.FilterByFocusArea(tasks, fa.ID),
+		}
+	}
+
+	m.selectColumn(m.col)
+
+	for idx := range m.columns {
+		m.clampCursor(idx)
+	}
+
+	return m, nil
+}
+
+// sortOrder orders cards the same way as the task list.
+func sortOrder(prefs store.Prefs) taskquery.Sort {
+	field, err := taskquery.ParseSortField(prefs.TaskListSort)
+	if err != nil {
+		return taskquery.Sort{}
+	}
+
+	return taskquery.Sort{Field: field, Descending: prefs.TaskListDescending}
+}
+
+func (m Model) taskOrder() []uint {
+	order, err := m.store.LoadTaskOrder()
+	if err != nil {
+		m.logger.Error("Error loading task order", "error", err)
+	}
+
+	return order
+}
+
+// moveCard moves the selected card to the column delta steps away and
+// follows it there.
+func (m Model) moveCard(delta int) (Model, tea.Cmd) {
+	task, ok := m.selectedTask()
+	if !ok {
+		return m, nil
+	}
+
+	target := m.col + delta
+	if target < 0 || target >= len(m.columns) {
+		return m, nil
+	}
+
+	focusArea := m.columns[target].focusArea
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	if err := taskops.Move(ctx, m.client, m.store, m.logger, task, focusArea.ID); err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("failed to move task: %w", err))
+	}
+
+	m, cmd := m.refresh()
+
+	m.selectColumn(target)
+	m.selectTask(task.ID)
+	m.status = fmt.Sprintf("Moved %q to %s", task.Summary, focusArea.Name)
+
+	return m, cmd
+}
+
+func (m Model) selectedTask() (soqapi.TaskDTO, bool) {
+	if m.col >= len(m.columns) {
+		return soqapi.TaskDTO{}, false
+	}
+
+	col := m.columns[m.col]
+
+	cursor := m.cursors[col.focusArea.ID]
+	if cursor >= len(col.tasks) {
+		return soqapi.TaskDTO{}, false
+	}
+
+	return col.tasks[cursor], true
+}
+
+// selectTask moves the cursor of the selected column to the given task.
+func (m *Model) selectTask(taskID uint) {
+	if m.col >= len(m.columns) {
+		return
+	}
+
+	col := m.columns[m.col]
+
+	for idx, task := range col.tasks {
+		if task.ID == taskID {
+			m.cursors[col.focusArea.ID] = idx
+		}
+	}
+}
+
+func (m *Model) selectColumn(idx int) {
+	m.col = max(0, min(idx, len(m.columns)-1))
+	m.scrollToColumn()
+}
+
+func (m *Model) moveCursor(delta int) {
+	if m.col >= len(m.columns) {
+		return
+	}
+
+	m.cursors[m.columns[m.col].focusArea.ID] += delta
+	m.clampCursor(m.col)
+}
+
+func (m *Model) clampCursor(colIdx int) {
+	col := m.columns[colIdx]
+	m.cursors[col.focusArea.ID] = max(0, min(m.cursors[col.focusArea.ID], len(col.tasks)-1))
+}
+
+// scrollToColumn scrolls horizontally so the selected column is shown.
+func (m *Model) scrollToColumn() {
+	visible := m.visibleColumns()
+
+	if m.col < m.offset {
+		m.offset = m.col
+	}
+
+	if m.col >= m.offset+visible {
+		m.offset = m.col - visible + 1
+	}
+
+	m.offset = max(0, min(m.offset, len(m.columns)-visible))
+}
+
+// visibleColumns returns how many columns fit in the terminal.
+func (m Model) visibleColumns() int {
+	fit := max(1, (m.width+columnGap)/(minColumnWidth+columnGap))
+
+	return max(1, min(fit, len(m.columns)))
+}
+
+func (m Model) renderHelp() string {
+	help := m.helpBar.View(m.help, m.keys)
+
+	if m.status == "" {
+		return help
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, statusStyle.Render(m.status), help)
+}
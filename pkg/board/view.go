@@ -0,0 +1,199 @@
+package board
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+const (
+	minColumnWidth = 28
+	columnGap      = 1
+
+	// cardHeight is the height of a card: a border around the summary and
+	// a line of details.
+	cardHeight = 4
+)
+
+var (
+	titleStyle = list.DefaultStyles().Title
+
+	scrollHintStyle = lipgloss.NewStyle().
+			Foreground(styles.DarkGray).
+			Padding(0, 1)
+
+	columnHeaderStyle = lipgloss.NewStyle().
+				Padding(0, 1).
+				Border(lipgloss.NormalBorder(), false, false, true, false).
+				BorderForeground(styles.DarkGray)
+
+	activeColumnHeaderStyle = columnHeaderStyle.
+				Bold(true).
+				BorderForeground(styles.HotPink)
+
+	cardStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(styles.DarkGray).
+			Padding(0, 1)
+
+	selectedCardStyle = cardStyle.
+				BorderForeground(styles.HotPink)
+
+	cardDetailStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+
+	emptyColumnStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Italic(true).
+				Padding(0, 1)
+
+	statusStyle = lipgloss.NewStyle().Foreground(styles.Amber)
+)
+
+func (m Model) View() string {
+	help := m.renderHelp()
+
+	header := m.renderTitle()
+	bodyHeight := m.height - lipgloss.Height(header) - lipgloss.Height(help) - 1
+
+	var body string
+	if len(m.columns) == 0 {
+		body = emptyColumnStyle.Render("No focus areas yet. Create one from settings.")
+	} else {
+		body = m.renderColumns(bodyHeight)
+	}
+
+	body = lipgloss.NewStyle().Height(bodyHeight).MaxHeight(bodyHeight).Render(body)
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, "", help)
+}
+
+// renderTitle renders the title with how many columns are scrolled out of
+// view on either side.
+func (m Model) renderTitle() string {
+	title := titleStyle.Render("Board")
+
+	visible := m.visibleColumns()
+
+	if m.offset > 0 {
+		title += scrollHintStyle.Render(fmt.Sprintf("‹ %d more", m.offset))
+	}
+
+	if hidden := len(m.columns) - m.offset - visible; hidden > 0 {
+		title += scrollHintStyle.Render(fmt.Sprintf("%d more ›", hidden))
+	}
+
+	return lipgloss.NewStyle().PaddingBottom(1).Render(title)
+}
+
+func (m Model) renderColumns(height int) string {
+	visible := m.visibleColumns()
+	width := (m.width - columnGap*(visible-1)) / visible
+
+	rendered := make([]string, 0, visible*2)
+
+	for idx := m.offset; idx < min(m.offset+visible, len(m.columns)); idx++ {
+		if len(rendered) > 0 {
+			rendered = append(rendered, strings.Repeat(" ", columnGap))
+		}
+
+		rendered = append(rendered, m.renderColumn(idx, width, height))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+}
+
+func (m Model) renderColumn(colIdx int, width int, height int) string {
+	col := m.columns[colIdx]
+	active := colIdx == m.col
+
+	headerStyle := columnHeaderStyle
+	if active {
+		headerStyle = activeColumnHeaderStyle
+	}
+
+	meta := m.areaMeta[col.focusArea.ID]
+	label := fmt.Sprintf("%s %d", styles.FocusAreaBadge(col.focusArea.Name, meta.Glyph, meta.Color), len(col.tasks))
+	header := zone.Mark(m.columnZoneID(colIdx), headerStyle.Width(width).Render(label))
+
+	lines := []string{header}
+
+	if len(col.tasks) == 0 {
+		lines = append(lines, emptyColumnStyle.Render("No tasks"))
+		return lipgloss.NewStyle().Width(width).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	}
+
+	// Keep a line for the count of cards below the fold.
+	fit := max(1, (height-lipgloss.Height(header)-1)/cardHeight)
+	cursor := m.cursors[col.focusArea.ID]
+	start := max(0, cursor-fit+1)
+	end := min(len(col.tasks), start+fit)
+
+	if start > 0 {
+		lines = append(lines, cardDetailStyle.Render(fmt.Sprintf(" ↑ %d more", start)))
+	}
+
+	for idx := start; idx < end; idx++ {
+		selected := active && idx == cursor
+		lines = append(lines, zone.Mark(m.cardZoneID(colIdx, idx), m.renderCard(col.tasks[idx], width, selected)))
+	}
+
+	if hidden := len(col.tasks) - end; hidden > 0 {
+		lines = append(lines, cardDetailStyle.Render(fmt.Sprintf(" ↓ %d more", hidden)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (m Model) renderCard(task soqapi.TaskDTO, width int, selected bool) string {
+	style := cardStyle
+	if selected {
+		style = selectedCardStyle
+	}
+
+	frameWidth, _ := style.GetFrameSize()
+	textWidth := width - frameWidth
+
+	details := fmt.Sprintf("#%d", task.ID)
+	if priority := m.taskMeta[task.ID].Priority; priority != store.PriorityNone {
+		details += " · " + priority.Label()
+	}
+
+	return style.Width(width - 2).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		truncate(task.Summary, textWidth),
+		cardDetailStyle.Render(truncate(details, textWidth)),
+	))
+}
+
+// truncate shortens s to fit width cells, ending it with an ellipsis.
+func truncate(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+
+	var b strings.Builder
+
+	for _, r := range s {
+		if lipgloss.Width(b.String()+string(r)) > width-1 {
+			break
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String() + "…"
+}
+
+func (m Model) columnZoneID(colIdx int) string {
+	return fmt.Sprintf("%scolumn-%d", m.zonePrefix, colIdx)
+}
+
+func (m Model) cardZoneID(colIdx, cardIdx int) string {
+	return fmt.Sprintf("%scard-%d-%d", m.zonePrefix, colIdx, cardIdx)
+}
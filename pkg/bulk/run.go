@@ -0,0 +1,65 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultLimit is how many requests a bulk action keeps in flight at once.
+const DefaultLimit = 4
+
+// Result is the outcome of a bulk action for one item.
+type Result[T any] struct {
+	Item T
+	Err  error
+}
+
+// Run calls fn for every item with at most limit calls running at once. The
+// results are in the same order as items.
+func Run[T any](ctx context.Context, items []T, limit int, fn func(ctx context.Context, item T) error) []Result[T] {
+	if limit < 1 {
+		limit = 1
+	}
+
+	results := make([]Result[T], len(items))
+	slots := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+
+	for idx, item := range items {
+		results[idx].Item = item
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			results[idx].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(idx int, item T) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			results[idx].Err = fn(ctx, item)
+		}(idx, item)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// Failed returns the results that have an error.
+func Failed[T any](results []Result[T]) []Result[T] {
+	failed := make([]Result[T], 0)
+
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
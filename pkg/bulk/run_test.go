@@ -0,0 +1,152 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunLimit(t *testing.T) {
+	tests := []struct {
+		limit int
+		want  int32
+	}{
+		{limit: 1, want: 1},
+		{limit: 3, want: 3},
+		{limit: 0, want: 1},
+		{limit: 20, want: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("limit %d", tt.limit), func(t *testing.T) {
+			var inFlight, most, calls atomic.Int32
+
+			items := make([]int, 10)
+
+			Run(context.Background(), items, tt.limit, func(ctx context.Context, item int) error {
+				calls.Add(1)
+
+				running := inFlight.Add(1)
+				defer inFlight.Add(-1)
+
+				for {
+					seen := most.Load()
+					if running <= seen || most.CompareAndSwap(seen, running) {
+						break
+					}
+				}
+
+				time.Sleep(5 * time.Millisecond)
+
+				return nil
+			})
+
+			if got := calls.Load(); got != int32(len(items)) {
+				t.Errorf("fn was called %d times, want %d", got, len(items))
+			}
+
+			if got := most.Load(); got != tt.want {
+				t.Errorf("at most %d calls ran at once, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunOrder(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	// Each call waits for the next item to finish, so they finish last to
+	// first.
+	finished := make([]chan struct{}, len(items)+1)
+	for idx := range finished {
+		finished[idx] = make(chan struct{})
+	}
+
+	close(finished[len(items)])
+
+	var order []string
+
+	results := Run(context.Background(), items, len(items), func(ctx context.Context, item string) error {
+		idx := int(item[0] - 'a')
+
+		<-finished[idx+1]
+		order = append(order, item)
+		close(finished[idx])
+
+		if item == "b" || item == "d" {
+			return fmt.Errorf("%s failed", item)
+		}
+
+		return nil
+	})
+
+	if want := []string{"e", "d", "c", "b", "a"}; !slices.Equal(order, want) {
+		t.Fatalf("calls finished in order %v, want %v", order, want)
+	}
+
+	for idx, result := range results {
+		if result.Item != items[idx] {
+			t.Errorf("results[%d].Item = %q, want %q", idx, result.Item, items[idx])
+		}
+
+		wantErr := ""
+		if result.Item == "b" || result.Item == "d" {
+			wantErr = result.Item + " failed"
+		}
+
+		if gotErr := errString(result.Err); gotErr != wantErr {
+			t.Errorf("results[%d].Err = %q, want %q", idx, gotErr, wantErr)
+		}
+	}
+
+	failed := Failed(results)
+	if len(failed) != 2 || failed[0].Item != "b" || failed[1].Item != "d" {
+		t.Errorf("Failed = %+v, want b and d", failed)
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := Run(ctx, []int{1, 2, 3, 4, 5, 6}, 2, func(ctx context.Context, item int) error {
+		return ctx.Err()
+	})
+
+	if len(results) != 6 {
+		t.Fatalf("got %d results, want 6", len(results))
+	}
+
+	for idx, result := range results {
+		if result.Item != idx+1 || !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("results[%d] = %+v, want item %d cancelled", idx, result, idx+1)
+		}
+	}
+}
+
+func TestRunEmpty(t *testing.T) {
+	results := Run(context.Background(), []int(nil), DefaultLimit, func(ctx context.Context, item int) error {
+		t.Errorf("fn called for %d", item)
+		return nil
+	})
+
+	if len(results) != 0 {
+		t.Errorf("got %d results, want none", len(results))
+	}
+
+	if failed := Failed(results); failed == nil || len(failed) != 0 {
+		t.Errorf("Failed = %#v, want an empty slice", failed)
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+// newClient builds an API client for a subcommand using the token saved by the
+// TUI login.
+func newClient(cmd *cobra.Command) (*api.Client, error) {
+	debug, _ := cmd.Flags().GetBool(debugFlagKey)
+	configDir, _ := cmd.Flags().GetString(configDirFlagKey)
+
+	client := api.NewClient(logger.New(debug), configDir)
+
+	if err := client.LoadToken(); err != nil {
+		return nil, fmt.Errorf("error loading token: %w", err)
+	}
+
+	if !client.IsAuthenticated() {
+		return nil, fmt.Errorf("not logged in, run qt to log in first")
+	}
+
+	return client, nil
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sinceFlagKey  = "since"
+	byFlagKey     = "by"
+	formatFlagKey = "format"
+
+	formatTable = "table"
+	formatCSV   = "csv"
+	formatJSON  = "json"
+
+	reportDayFormat = "Mon 2006-01-02"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize local task history",
+}
+
+var reportTimeCmd = &cobra.Command{
+	Use:   "time",
+	Short: "Show daily and weekly totals of tracked time",
+	Long: "Show daily and weekly totals of the time tracked from the task list.\n\n" +
+		"Weeks start on Monday. A running timer counts up to now.",
+	Example: "  qt report time --since 7d --by focus-area\n" +
+		"  qt report time --since 2026-10-01 --by task --format csv",
+	Args: cobra.NoArgs,
+	RunE: runReportTime,
+}
+
+func runReportTime(cmd *cobra.Command, args []string) error {
+	now := time.Now()
+
+	sinceValue, _ := cmd.Flags().GetString(sinceFlagKey)
+	since, err := timetracking.ParseSince(sinceValue, now)
+	if err != nil {
+		return err
+	}
+
+	byValue, _ := cmd.Flags().GetString(byFlagKey)
+	by, err := timetracking.ParseGrouping(byValue)
+	if err != nil {
+		return err
+	}
+
+	configDir, _ := cmd.Flags().GetString(configDirFlagKey)
+
+	entries, err := store.New(configDir).LoadTimeEntries()
+	if err != nil {
+		return err
+	}
+
+	report := timetracking.BuildReport(entries, since, now, by)
+
+	format, _ := cmd.Flags().GetString(formatFlagKey)
+
+	switch format {
+	case formatTable:
+		return printReportTable(os.Stdout, report)
+	case formatCSV:
+		return printReportCSV(os.Stdout, report)
+	case formatJSON:
+		return printReportJSON(os.Stdout, report)
+	}
+
+	return fmt.Errorf("unknown format %q, expected table, csv or json", format)
+}
+
+func printReportTable(out io.Writer, report timetracking.Report) error {
+	if len(report.Daily) == 0 {
+		_, err := fmt.Fprintf(out, "No time tracked since %s\n", report.Since.Format(cliTimeFormat))
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	for idx, period := range reportPeriods(report) {
+		if idx > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\tTIME\n", period.Heading, strings.ToUpper(report.By.Label()))
+
+		var sum time.Duration
+		for _, total := range period.Totals {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", total.Start.Format(reportDayFormat), total.Group, timetracking.FormatDuration(total.Duration))
+			sum += total.Duration
+		}
+
+		fmt.Fprintf(w, "\tTotal\t%s\n", timetracking.FormatDuration(sum))
+	}
+
+	return w.Flush()
+}
+
+func printReportCSV(out io.Writer, report timetracking.Report) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{"period", "start", string(report.By), "seconds", "hours"}); err != nil {
+		return fmt.Errorf("error writing report: %w", err)
+	}
+
+	for _, period := range reportPeriods(report) {
+		for _, total := range period.Totals {
+			record := []string{
+				period.Name,
+				total.Start.Format(time.DateOnly),
+				total.Group,
+				strconv.FormatInt(int64(total.Duration/time.Second), 10),
+				strconv.FormatFloat(total.Duration.Hours(), 'f', 2, 64),
+			}
+
+			if err := w.Write(record); err != nil {
+				return fmt.Errorf("error writing report: %w", err)
+			}
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
+
+type reportTotalJSON struct {
+	Start   string  `json:"start"`
+	Group   string  `json:"group"`
+	Seconds int64   `json:"seconds"`
+	Hours   float64 `json:"hours"`
+}
+
+type reportJSON struct {
+	Since  time.Time         `json:"since"`
+	Until  time.Time         `json:"until"`
+	By     string            `json:"by"`
+	Daily  []reportTotalJSON `json:"daily"`
+	Weekly []reportTotalJSON `json:"weekly"`
+}
+
+func printReportJSON(out io.Writer, report timetracking.Report) error {
+	toJSON := func(totals []timetracking.Total) []reportTotalJSON {
+		result := make([]reportTotalJSON, len(totals))
+		for idx, total := range totals {
+			result[idx] = reportTotalJSON{
+				Start:   total.Start.Format(time.DateOnly),
+				Group:   total.Group,
+				Seconds: int64(total.Duration / time.Second),
+				Hours:   math.Round(total.Duration.Hours()*100) / 100,
+			}
+		}
+
+		return result
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(reportJSON{
+		Since:  report.Since,
+		Until:  report.Until,
+		By:     string(report.By),
+		Daily:  toJSON(report.Daily),
+		Weekly: toJSON(report.Weekly),
+	})
+	if err != nil {
+		return fmt.Errorf("error writing report: %w", err)
+	}
+
+	return nil
+}
+
+type reportPeriod struct {
+	Name    string
+	Heading string
+	Totals  []timetracking.Total
+}
+
+func reportPeriods(report timetracking.Report) []reportPeriod {
+	return []reportPeriod{
+		{Name: "day", Heading: "DAY", Totals: report.Daily},
+		{Name: "week", Heading: "WEEK OF", Totals: report.Weekly},
+	}
+}
+
+func init() {
+	reportTimeCmd.Flags().String(sinceFlagKey, "7d", "start of the report: days (7d), weeks (2w), hours (12h) or a date (2006-01-02)")
+	reportTimeCmd.Flags().String(byFlagKey, string(timetracking.ByFocusArea), "group totals by focus-area or task")
+	reportTimeCmd.Flags().String(formatFlagKey, formatTable, "output format: table, csv or json")
+
+	reportCmd.AddCommand(reportTimeCmd)
+	rootCmd.AddCommand(reportCmd)
+}
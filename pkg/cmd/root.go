@@ -19,6 +19,10 @@ var debugEnabled bool
 
 var rootCmd = &cobra.Command{
 	Use: "qt",
+
+	// main reports errors, and usage is only useful for invalid flags.
+	SilenceErrors: true,
+	SilenceUsage:  true,
 	Run: func(cmd *cobra.Command, args []string) {
 		debug, _ := cmd.Flags().GetBool(debugFlagKey)
 		configDir, _ := cmd.Flags().GetString(configDirFlagKey)
@@ -28,7 +32,7 @@ var rootCmd = &cobra.Command{
 			app.WithConfigDir(configDir),
 		)
 
-		if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		if _, err := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion()).Run(); err != nil {
 			fmt.Println("Error running program:", err)
 			os.Exit(1)
 		}
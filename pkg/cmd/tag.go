@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/tags"
+	"github.com/spf13/cobra"
+)
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Manage task tags",
+	Long:  "Manage the tags on tasks. Tags are kept with the local task details, so changes apply to this machine.",
+}
+
+var tagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tags with the number of tasks carrying each",
+	Args:  cobra.NoArgs,
+	RunE:  runTagList,
+}
+
+var tagRenameCmd = &cobra.Command{
+	Use:     "rename <tag> <new-name>",
+	Short:   "Rename a tag on every task",
+	Example: "  qt tag rename waiting waiting-on",
+	Args:    cobra.ExactArgs(2),
+	RunE:    runTagRename,
+}
+
+var tagMergeCmd = &cobra.Command{
+	Use:     "merge <tag>... <into>",
+	Short:   "Replace tags with another tag on every task",
+	Example: "  qt tag merge urgent asap important",
+	Args:    cobra.MinimumNArgs(2),
+	RunE:    runTagMerge,
+}
+
+func runTagList(cmd *cobra.Command, args []string) error {
+	configDir, _ := cmd.Flags().GetString(configDirFlagKey)
+
+	counts, err := store.New(configDir).TagCounts()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tTASKS")
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%s\t%d\n", name, counts[name])
+	}
+
+	return w.Flush()
+}
+
+func runTagRename(cmd *cobra.Command, args []string) error {
+	return replaceTags(cmd, "Renamed %s to %s on %d %s\n", args[:1], args[1])
+}
+
+func runTagMerge(cmd *cobra.Command, args []string) error {
+	return replaceTags(cmd, "Merged %s into %s on %d %s\n", args[:len(args)-1], args[len(args)-1])
+}
+
+// replaceTags replaces the tags in from with to on every task, and reports how
+// many tasks changed with report.
+func replaceTags(cmd *cobra.Command, report string, from []string, to string) error {
+	target := tags.Normalize(to)
+	if target == "" {
+		return fmt.Errorf("invalid tag name %q", to)
+	}
+
+	sources := make([]string, 0, len(from))
+
+	for _, raw := range from {
+		source := tags.Normalize(raw)
+		if source == "" {
+			return fmt.Errorf("invalid tag name %q", raw)
+		}
+
+		if source != target {
+			sources = append(sources, source)
+		}
+	}
+
+	if len(sources) == 0 {
+		return fmt.Errorf("nothing to change, the tags are already named %s", target)
+	}
+
+	configDir, _ := cmd.Flags().GetString(configDirFlagKey)
+
+	changed, err := store.New(configDir).RenameTags(sources, target)
+	if err != nil {
+		return err
+	}
+
+	if changed == 0 {
+		return fmt.Errorf("no tasks are tagged %s", tags.Format(sources))
+	}
+
+	noun := "tasks"
+	if changed == 1 {
+		noun = "task"
+	}
+
+	fmt.Printf(report, tags.Format(sources), target, changed, noun)
+
+	return nil
+}
+
+func init() {
+	tagCmd.AddCommand(tagListCmd)
+	tagCmd.AddCommand(tagRenameCmd)
+	tagCmd.AddCommand(tagMergeCmd)
+	rootCmd.AddCommand(tagCmd)
+}
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/tags"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+	"github.com/spf13/cobra"
+)
+
+const (
+	focusAreaFlagKey = "focus-area"
+	resolvedFlagKey  = "resolved"
+	snoozedFlagKey   = "snoozed"
+	viewFlagKey      = "view"
+	tagFlagKey       = "tag"
+
+	cliRequestTimeout = 10 * time.Second
+	cliTimeFormat     = "2006-01-02 15:04"
+)
+
+var taskCmd = &cobra.Command{
+	Use:   "task",
+	Short: "Work with tasks from the command line",
+}
+
+var taskListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List open tasks",
+	Args:  cobra.NoArgs,
+	RunE:  runTaskList,
+}
+
+var taskSearchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search open tasks",
+	Long: "Search open tasks with the same query language as the task list search bar.\n\n" +
+		"Syntax: " + taskquery.QuerySyntax + "\n\n" +
+		`Example: qt task search 'area:work notes:"deploy" -resolved id:>100'`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTaskSearch,
+}
+
+func runTaskSearch(cmd *cobra.Command, args []string) error {
+	query, err := taskquery.ParseQuery(strings.Join(args, " "))
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), cliRequestTimeout)
+	defer cancel()
+
+	tasks, err := client.ListTasks(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing tasks: %w", err)
+	}
+
+	configDir, _ := cmd.Flags().GetString(configDirFlagKey)
+
+	meta, err := store.New(configDir).LoadTaskMeta()
+	if err != nil {
+		return err
+	}
+
+	return printTasks(taskquery.FilterTasks(tasks, query, meta), meta, nil)
+}
+
+func runTaskList(cmd *cobra.Command, args []string) error {
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), cliRequestTimeout)
+	defer cancel()
+
+	configDir, _ := cmd.Flags().GetString(configDirFlagKey)
+	configStore := store.New(configDir)
+
+	var (
+		tasks      []soqapi.TaskDTO
+		resolvedAt map[uint]time.Time
+	)
+
+	if resolved, _ := cmd.Flags().GetBool(resolvedFlagKey); resolved {
+		tasks, resolvedAt, err = loadResolvedTasks(configStore)
+	} else {
+		tasks, err = client.ListTasks(ctx)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing tasks: %w", err)
+	}
+
+	focusAreas, err := client.ListFocusAreas(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing focus areas: %w", err)
+	}
+
+	var view taskquery.View
+
+	viewName, _ := cmd.Flags().GetString(viewFlagKey)
+	if viewName != "" {
+		view, err = loadView(configStore, focusAreas, viewName)
+		if err != nil {
+			return err
+		}
+	}
+
+	focusAreaName, _ := cmd.Flags().GetString(focusAreaFlagKey)
+	if focusAreaName != "" {
+		focusArea, err := taskquery.FindFocusArea(focusAreas, focusAreaName)
+		if err != nil {
+			return err
+		}
+
+		view.FocusAreaID = focusArea.ID
+	}
+
+	meta, err := configStore.LoadTaskMeta()
+	if err != nil {
+		return err
+	}
+
+	// Snoozing only hides open tasks, so the resolved history lists them all.
+	if snoozed, _ := cmd.Flags().GetBool(snoozedFlagKey); !snoozed && resolvedAt == nil {
+		tasks = taskquery.WithoutSnoozed(tasks, meta, time.Now())
+	}
+
+	tagNames, _ := cmd.Flags().GetStringSlice(tagFlagKey)
+
+	tasks = taskquery.FilterByFocusArea(tasks, view.FocusAreaID)
+	tasks = taskquery.FilterByTags(tasks, tags.Parse(strings.Join(tagNames, ",")), meta)
+	tasks = taskquery.FilterTasks(tasks, view.Query, meta)
+
+	if view.Sort.Field != taskquery.SortDefault {
+		manual, err := configStore.LoadTaskOrder()
+		if err != nil {
+			return err
+		}
+
+		tasks = taskquery.SortTasks(tasks, view.Sort, meta, manual)
+	}
+
+	if view.Group {
+		return printGroupedTasks(taskquery.GroupByFocusArea(tasks), meta, resolvedAt)
+	}
+
+	return printTasks(tasks, meta, resolvedAt)
+}
+
+// loadResolvedTasks reads the local history of resolved tasks, newest first.
+func loadResolvedTasks(configStore *store.Store) ([]soqapi.TaskDTO, map[uint]time.Time, error) {
+	history, err := configStore.LoadResolvedTasks()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tasks := make([]soqapi.TaskDTO, len(history))
+	resolvedAt := make(map[uint]time.Time, len(history))
+
+	for idx, resolved := range history {
+		tasks[idx] = resolved.Task
+		resolvedAt[resolved.Task.ID] = resolved.ResolvedAt
+	}
+
+	return tasks, resolvedAt, nil
+}
+
+func loadView(configStore *store.Store, focusAreas []soqapi.FocusAreaDTO, name string) (taskquery.View, error) {
+	views, err := configStore.LoadViews()
+	if err != nil {
+		return taskquery.View{}, err
+	}
+
+	saved, err := taskquery.FindView(views, name)
+	if err != nil {
+		return taskquery.View{}, err
+	}
+
+	return taskquery.ResolveView(saved, focusAreas)
+}
+
+// printTasks writes a table of tasks, with a resolution time column when
+// resolvedAt is not nil.
+func printTasks(tasks []soqapi.TaskDTO, meta map[uint]store.TaskMeta, resolvedAt map[uint]time.Time) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if resolvedAt == nil {
+		fmt.Fprintln(w, "ID\tSUMMARY\tFOCUS AREA\tTAGS")
+	} else {
+		fmt.Fprintln(w, "ID\tSUMMARY\tFOCUS AREA\tTAGS\tRESOLVED")
+	}
+
+	for _, task := range tasks {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s", task.ID, task.Summary, task.FocusArea.Name, tags.Format(meta[task.ID].Tags))
+
+		if resolvedAt != nil {
+			fmt.Fprintf(w, "\t%s", formatResolvedAt(resolvedAt[task.ID]))
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	return w.Flush()
+}
+
+func formatResolvedAt(t time.Time) string {
+	return t.Local().Format(cliTimeFormat)
+}
+
+func printGroupedTasks(groups []taskquery.Group, meta map[uint]store.TaskMeta, resolvedAt map[uint]time.Time) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	for idx, group := range groups {
+		if idx > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "%s (%d)\n", group.FocusArea.Name, len(group.Tasks))
+
+		for _, task := range group.Tasks {
+			fmt.Fprintf(w, "  %d\t%s\t%s", task.ID, task.Summary, tags.Format(meta[task.ID].Tags))
+
+			if resolvedAt != nil {
+				fmt.Fprintf(w, "\t%s", formatResolvedAt(resolvedAt[task.ID]))
+			}
+
+			fmt.Fprintln(w)
+		}
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	taskListCmd.Flags().String(focusAreaFlagKey, "", "only list tasks in this focus area (name or ID)")
+	taskListCmd.Flags().String(viewFlagKey, "", "apply a saved view from the task list")
+	taskListCmd.Flags().Bool(resolvedFlagKey, false, "list tasks resolved from this machine instead of open tasks")
+	taskListCmd.Flags().Bool(snoozedFlagKey, false, "also list snoozed tasks")
+	taskListCmd.Flags().StringSlice(tagFlagKey, nil, "only list tasks with this tag; repeat to require several")
+
+	taskCmd.AddCommand(taskListCmd)
+	taskCmd.AddCommand(taskSearchCmd)
+	rootCmd.AddCommand(taskCmd)
+}
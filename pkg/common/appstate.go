@@ -10,4 +10,10 @@ const (
 	AppStateTaskList
 	AppStateTaskForm
 	AppStateSettings
+	AppStateBoard
+	AppStateFocusTimer
+	AppStateTimeLog
+	AppStateTimeEntryForm
+	AppStateAgenda
+	AppStateReview
 )
@@ -0,0 +1,17 @@
+package common
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	soqapi "github.com/mole-squad/soq-api/api"
+)
+
+// FocusTaskMsg starts the focus timer on a task.
+type FocusTaskMsg struct {
+	Task soqapi.TaskDTO
+}
+
+func NewFocusTaskMsg(task soqapi.TaskDTO) tea.Cmd {
+	return func() tea.Msg {
+		return FocusTaskMsg{Task: task}
+	}
+}
@@ -5,12 +5,21 @@ import (
 	soqapi "github.com/mole-squad/soq-api/api"
 )
 
+// SelectTaskMsg opens a task in the task form, which goes to ReturnState once
+// the task is saved or the edit cancelled.
 type SelectTaskMsg struct {
-	Task soqapi.TaskDTO
+	Task        soqapi.TaskDTO
+	ReturnState AppState
 }
 
 func NewSelectTaskMsg(task soqapi.TaskDTO) tea.Cmd {
+	return NewSelectTaskMsgFrom(task, AppStateTaskList)
+}
+
+// NewSelectTaskMsgFrom opens a task in the task form from a view other than
+// the task list, and returns to it afterwards.
+func NewSelectTaskMsgFrom(task soqapi.TaskDTO, returnState AppState) tea.Cmd {
 	return func() tea.Msg {
-		return SelectTaskMsg{Task: task}
+		return SelectTaskMsg{Task: task, ReturnState: returnState}
 	}
 }
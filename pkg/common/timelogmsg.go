@@ -0,0 +1,26 @@
+package common
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// TimeLogChangedMsg reports that time tracking was started, stopped or edited,
+// so views showing tracked time reload it.
+type TimeLogChangedMsg struct{}
+
+func NewTimeLogChangedMsg() tea.Cmd {
+	return func() tea.Msg {
+		return TimeLogChangedMsg{}
+	}
+}
+
+type EditTimeEntryMsg struct {
+	Entry store.TimeEntry
+}
+
+func NewEditTimeEntryMsg(entry store.TimeEntry) tea.Cmd {
+	return func() tea.Msg {
+		return EditTimeEntryMsg{Entry: entry}
+	}
+}
@@ -0,0 +1,31 @@
+package confirm
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Confirm key.Binding
+	Deny    key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Confirm: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "yes"),
+		),
+		Deny: key.NewBinding(
+			key.WithKeys("n", "esc"),
+			key.WithHelp("n", "no"),
+		),
+	}
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Confirm, k.Deny}
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Confirm, k.Deny},
+	}
+}
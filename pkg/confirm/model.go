@@ -0,0 +1,109 @@
+package confirm
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+// Answer is the outcome of a key press or click while a dialog is open.
+type Answer int
+
+const (
+	Unanswered Answer = iota
+	Confirmed
+	Denied
+)
+
+var dialogStyle = styles.BorderStyle.Padding(0, 1)
+
+// Model is a yes/no dialog. While it is open the owning view should send it
+// every key and mouse message and act on the returned answer.
+type Model struct {
+	question string
+	open     bool
+
+	keys    keyMap
+	help    help.Model
+	helpBar mouse.HelpBar
+}
+
+func New() Model {
+	return Model{
+		keys:    newKeyMap(),
+		help:    help.New(),
+		helpBar: mouse.NewHelpBar(),
+	}
+}
+
+// Ask opens the dialog with a question.
+func (m Model) Ask(question string) Model {
+	m.question = question
+	m.open = true
+
+	return m
+}
+
+func (m Model) IsOpen() bool {
+	return m.open
+}
+
+// Update closes the dialog once the question is answered.
+func (m Model) Update(msg tea.Msg) (Model, Answer) {
+	if !m.open {
+		return m, Unanswered
+	}
+
+	var keyMsg tea.KeyMsg
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		keyMsg = msg
+
+	case tea.MouseMsg:
+		clicked, ok := m.helpBar.Clicked(m.keys, msg)
+		if !ok {
+			return m, Unanswered
+		}
+
+		keyMsg = clicked
+
+	default:
+		return m, Unanswered
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.keys.Confirm):
+		m.open = false
+		return m, Confirmed
+
+	case key.Matches(keyMsg, m.keys.Deny):
+		m.open = false
+		return m, Denied
+	}
+
+	return m, Unanswered
+}
+
+func (m Model) View() string {
+	if !m.open {
+		return ""
+	}
+
+	return dialogStyle.Render(lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		styles.InputLabelStyle.Render(m.question),
+		"  ",
+		m.helpBar.View(m.help, m.keys),
+	))
+}
+
+// Close dismisses the dialog without an answer.
+func (m Model) Close() Model {
+	m.open = false
+
+	return m
+}
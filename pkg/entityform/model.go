@@ -0,0 +1,159 @@
+package entityform
+
+import (
+	"fmt"
+	"reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/forms"
+	"github.com/mole-squad/soq-tui/pkg/utils"
+)
+
+const (
+	newDraftKey  = "new"
+	editDraftKey = "edit"
+)
+
+// SubmitFunc persists an entity edited through the form. isNew reports whether
+// the form was opened with Create rather than Edit.
+type SubmitFunc[T any] func(entity T, isNew bool) error
+
+// Model is a form view that maps an entity of type T to and from a schema.
+// Entity fields are matched to schema fields the same way forms.Bind matches
+// them, so fields absent from the schema (IDs, nested DTOs) are carried over
+// from the entity passed to Edit untouched.
+type Model[T any] struct {
+	schema forms.Schema
+	form   forms.Model
+
+	entity T
+	isNew  bool
+
+	onSubmit    SubmitFunc[T]
+	returnState common.AppState
+}
+
+func New[T any](schema forms.Schema, returnState common.AppState, onSubmit SubmitFunc[T], opts ...forms.FormModelOption) Model[T] {
+	return Model[T]{
+		schema:      schema,
+		form:        schema.MustBuild(opts...),
+		onSubmit:    onSubmit,
+		returnState: returnState,
+	}
+}
+
+func (m Model[T]) Init() tea.Cmd {
+	return m.form.Init()
+}
+
+func (m Model[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case forms.SubmitFormMsg:
+		if msg.FormID == m.schema.ID {
+			cmd = m.submit()
+			return m, cmd
+		}
+
+	case forms.CancelFormMsg:
+		if msg.FormID == m.schema.ID {
+			return m, common.AppStateCmd(m.returnState)
+		}
+	}
+
+	m.form, cmd = utils.ApplyUpdate(m.form, msg)
+
+	return m, cmd
+}
+
+func (m Model[T]) View() string {
+	return m.form.View()
+}
+
+func (m Model[T]) Blur() (tea.Model, tea.Cmd) {
+	return m, m.form.Blur()
+}
+
+func (m Model[T]) Focus() (tea.Model, tea.Cmd) {
+	return m, m.form.Focus()
+}
+
+// Create resets the form for a new entity. Zero-valued fields of defaults fall
+// back to the schema defaults.
+func (m Model[T]) Create(defaults T) (Model[T], tea.Cmd) {
+	m.isNew = true
+	m.entity = defaults
+
+	values := forms.ValuesOf(defaults)
+	for id, value := range values {
+		if value == nil || reflect.ValueOf(value).IsZero() {
+			delete(values, id)
+		}
+	}
+
+	return m, forms.NewSetValuesCmd(m.schema.ID, newDraftKey, values)
+}
+
+// Edit loads an existing entity into the form.
+func (m Model[T]) Edit(entity T) (Model[T], tea.Cmd) {
+	m.isNew = false
+	m.entity = entity
+
+	return m, forms.NewSetValuesCmd(m.schema.ID, draftKey(entity), forms.ValuesOf(entity))
+}
+
+// ReturnTo sets the view the form goes to once submitted or cancelled.
+func (m Model[T]) ReturnTo(returnState common.AppState) Model[T] {
+	m.returnState = returnState
+
+	return m
+}
+
+func (m Model[T]) IsNew() bool {
+	return m.isNew
+}
+
+// Entity returns the entity passed to Create or Edit with the current form
+// values applied.
+func (m Model[T]) Entity() (T, error) {
+	entity := m.entity
+
+	if err := m.form.Bind(&entity); err != nil {
+		return entity, fmt.Errorf("error reading %s: %w", m.schema.ID, err)
+	}
+
+	return entity, nil
+}
+
+func (m *Model[T]) submit() tea.Cmd {
+	entity, err := m.Entity()
+	if err != nil {
+		return common.NewErrorMsg(err)
+	}
+
+	if err := m.onSubmit(entity, m.isNew); err != nil {
+		return common.NewErrorMsg(err)
+	}
+
+	m.form.ClearDraft()
+
+	return common.AppStateCmd(m.returnState)
+}
+
+// draftKey identifies an existing entity by its ID field so each record gets
+// its own draft.
+func draftKey(entity any) string {
+	entityVal := reflect.Indirect(reflect.ValueOf(entity))
+	if entityVal.Kind() != reflect.Struct {
+		return editDraftKey
+	}
+
+	idField := entityVal.FieldByName("ID")
+	if !idField.IsValid() {
+		return editDraftKey
+	}
+
+	return fmt.Sprint(idField.Interface())
+}
@@ -3,48 +3,79 @@ package focusareaform
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	soqapi "github.com/mole-squad/soq-api/api"
 	"github.com/mole-squad/soq-tui/pkg/api"
 	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/entityform"
 	"github.com/mole-squad/soq-tui/pkg/forms"
 	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
 	"github.com/mole-squad/soq-tui/pkg/utils"
 )
 
 const (
 	focusAreaFormID = "focusareaform"
 	nameFieldID     = "name"
+	colorFieldID    = "color"
+	glyphFieldID    = "glyph"
 )
 
+var schema = forms.NewSchema(
+	focusAreaFormID,
+	forms.TextField(
+		nameFieldID,
+		"Name",
+		forms.WithValidators(forms.Required(), forms.MaxLength(64)),
+	),
+	forms.PaletteField(colorFieldID, "Color"),
+	forms.TextField(
+		glyphFieldID,
+		"Icon",
+		forms.WithHelp("An emoji or symbol shown before the name"),
+		forms.WithValidators(singleGlyph()),
+	),
+)
+
+// focusAreaEntity is the editable view of a focus area. The color and icon are
+// not stored by the API and are saved as local metadata.
+type focusAreaEntity struct {
+	ID    uint   `form:"-"`
+	Name  string `form:"name"`
+	Color string `form:"color"`
+	Glyph string `form:"glyph"`
+}
+
 type Model struct {
 	client *api.Client
 	logger *logger.Logger
+	store  *store.Store
 
-	isNew     bool
-	focusArea soqapi.FocusAreaDTO
-
-	form forms.Model
+	form entityform.Model[focusAreaEntity]
 }
 
-func New(logger *logger.Logger, client *api.Client) common.AppView {
-	name := forms.NewTextInput(nameFieldID, "Name")
-
-	form := forms.New(
-		focusAreaFormID,
-		forms.WithField(name),
-	)
-
-	return Model{
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	model := Model{
 		client: client,
 		logger: logger,
-		form:   form,
+		store:  store,
 	}
+
+	model.form = entityform.New(
+		schema,
+		common.AppStateFocusAreaList,
+		model.saveFocusArea,
+		forms.WithDrafts(store, logger),
+	)
+
+	return model
 }
 
 func (m Model) Init() tea.Cmd {
-	return m.form.Focus()
+	return m.form.Init()
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -52,15 +83,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case common.CreateFocusAreaMsg:
-		return m.onCreate()
+		m.form, cmd = m.form.Create(focusAreaEntity{})
+		return m, cmd
 
 	case common.EditFocusAreaMsg:
-		return m.onEdit(msg.FocusArea)
-
-	case forms.SubmitFormMsg:
-		if msg.FormID == focusAreaFormID {
-			return m.onSubmit()
-		}
+		m.form, cmd = m.form.Edit(m.newEntity(msg.FocusArea))
+		return m, cmd
 	}
 
 	m.form, cmd = utils.ApplyUpdate(m.form, msg)
@@ -77,76 +105,71 @@ func (m Model) Blur() (tea.Model, tea.Cmd) {
 }
 
 func (m Model) Focus() (tea.Model, tea.Cmd) {
-	return m, m.form.Focus()
-}
-
-func (m Model) onCreate() (tea.Model, tea.Cmd) {
-	m.isNew = true
-	m.focusArea = soqapi.FocusAreaDTO{
-		Name: "",
-	}
-
-	return m, tea.Batch(
-		forms.NewSetFieldValueCmd(focusAreaFormID, nameFieldID, m.focusArea.Name),
-	)
-}
-
-func (m Model) onEdit(focusArea soqapi.FocusAreaDTO) (tea.Model, tea.Cmd) {
-	m.isNew = false
-	m.focusArea = focusArea
+	var cmd tea.Cmd
+	m.form, cmd = utils.ApplyFocus(m.form)
 
-	return m, tea.Batch(
-		forms.NewSetFieldValueCmd(focusAreaFormID, nameFieldID, m.focusArea.Name),
-	)
+	return m, cmd
 }
 
-func (m Model) onSubmit() (tea.Model, tea.Cmd) {
-	values := m.form.Value()
-
-	name := values[nameFieldID]
-
-	var err error
-	if m.isNew {
-		err = m.createFocusArea(name)
-	} else {
-		err = m.updateFocusArea(name)
-	}
-
+func (m Model) newEntity(focusArea soqapi.FocusAreaDTO) focusAreaEntity {
+	metas, err := m.store.LoadFocusAreaMeta()
 	if err != nil {
-		return m, common.NewErrorMsg(err)
+		m.logger.Error("Error loading focus area metadata", "error", err)
 	}
 
-	return m, common.AppStateCmd(common.AppStateFocusAreaList)
+	meta := metas[focusArea.ID]
+
+	return focusAreaEntity{
+		ID:    focusArea.ID,
+		Name:  focusArea.Name,
+		Color: meta.Color,
+		Glyph: meta.Glyph,
+	}
 }
 
-func (m Model) createFocusArea(name string) error {
+func (m Model) saveFocusArea(focusArea focusAreaEntity, isNew bool) error {
 	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
 	defer cancel()
 
-	dto := soqapi.CreateFocusAreaRequestDTO{
-		Name: name,
+	meta := store.FocusAreaMeta{
+		Color: focusArea.Color,
+		Glyph: strings.TrimSpace(focusArea.Glyph),
 	}
 
-	_, err := m.client.CreateFocusArea(ctx, &dto)
-	if err != nil {
-		return fmt.Errorf("error creating focus area: %w", err)
-	}
+	if isNew {
+		dto := soqapi.CreateFocusAreaRequestDTO{
+			Name: focusArea.Name,
+		}
 
-	return nil
-}
+		created, err := m.client.CreateFocusArea(ctx, &dto)
+		if err != nil {
+			return fmt.Errorf("error creating focus area: %w", err)
+		}
 
-func (m Model) updateFocusArea(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
-	defer cancel()
+		return m.store.SaveFocusAreaMeta(created.ID, meta)
+	}
 
 	dto := soqapi.UpdateFocusAreaRequestDTO{
-		Name: name,
+		Name: focusArea.Name,
 	}
 
-	_, err := m.client.UpdateFocusArea(ctx, m.focusArea.ID, &dto)
-	if err != nil {
+	if _, err := m.client.UpdateFocusArea(ctx, focusArea.ID, &dto); err != nil {
 		return fmt.Errorf("error updating focus area: %w", err)
 	}
 
-	return nil
+	return m.store.SaveFocusAreaMeta(focusArea.ID, meta)
+}
+
+// singleGlyph limits the icon to what fits in two terminal cells, which allows
+// one emoji.
+func singleGlyph() forms.Validator {
+	return func(value any) error {
+		glyph, _ := value.(string)
+
+		if lipgloss.Width(strings.TrimSpace(glyph)) > 2 {
+			return fmt.Errorf("must be a single emoji or symbol")
+		}
+
+		return nil
+	}
 }
@@ -0,0 +1,400 @@
+package focusarealist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/bulk"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/confirm"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskops"
+)
+
+// deleteStage tracks the dialog shown when the focus area being deleted still
+// has open tasks.
+type deleteStage int
+
+const (
+	deleteIdle deleteStage = iota
+	deleteChooseAction
+	deleteChooseTarget
+)
+
+// taskDisposal is what happens to the open tasks of a deleted focus area.
+type taskDisposal int
+
+const (
+	keepTasks taskDisposal = iota
+	moveTasks
+	deleteTasks
+)
+
+var (
+	dialogStyle = styles.BorderStyle.Padding(0, 1)
+
+	activeTargetStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("#FFFFFF")).
+				Background(styles.HotPink).
+				Padding(0, 1)
+
+	inactiveTargetStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Padding(0, 1)
+)
+
+func (m Model) onConfirmMsg(msg tea.Msg) (Model, tea.Cmd) {
+	var answer confirm.Answer
+	m.confirm, answer = m.confirm.Update(msg)
+
+	if answer == confirm.Unanswered {
+		return m, nil
+	}
+
+	m.resizeList()
+
+	if answer == confirm.Denied {
+		return m, nil
+	}
+
+	return m.deleteFocusArea(keepTasks, soqapi.FocusAreaDTO{})
+}
+
+func (m Model) onDeleteDialogMsg(msg tea.Msg) (Model, tea.Cmd) {
+	keys := m.deleteDialogKeys()
+
+	if mouseMsg, ok := msg.(tea.MouseMsg); ok {
+		clicked, ok := m.helpBar.Clicked(keys, mouseMsg)
+		if !ok {
+			return m, nil
+		}
+
+		msg = clicked
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.deleteKeys.MoveTasks):
+		m.deleteStage = deleteChooseTarget
+		m.targetIdx = 0
+
+	case key.Matches(keyMsg, m.deleteKeys.DeleteTasks):
+		m.deleteStage = deleteIdle
+		m.resizeList()
+
+		return m.deleteFocusArea(deleteTasks, soqapi.FocusAreaDTO{})
+
+	case key.Matches(keyMsg, m.deleteKeys.NextTarget):
+		m.targetIdx = (m.targetIdx + 1) % len(m.moveTargets())
+
+	case key.Matches(keyMsg, m.deleteKeys.PrevTarget):
+		m.targetIdx = (m.targetIdx - 1 + len(m.moveTargets())) % len(m.moveTargets())
+
+	case key.Matches(keyMsg, m.deleteKeys.ConfirmTarget):
+		m.deleteStage = deleteIdle
+		m.resizeList()
+
+		return m.deleteFocusArea(moveTasks, m.moveTargets()[m.targetIdx])
+
+	case key.Matches(keyMsg, m.deleteKeys.Cancel):
+		if m.deleteStage == deleteChooseTarget {
+			m.deleteStage = deleteChooseAction
+		} else {
+			m.deleteStage = deleteIdle
+		}
+	}
+
+	m.resizeList()
+
+	return m, nil
+}
+
+// moveTargets returns the focus areas the tasks of the pending deletion can be
+// moved to.
+func (m Model) moveTargets() []soqapi.FocusAreaDTO {
+	targets := make([]soqapi.FocusAreaDTO, 0, len(m.focusAreas))
+
+	for _, fa := range m.focusAreas {
+		if fa.ID != m.pendingDelete.ID {
+			targets = append(targets, fa)
+		}
+	}
+
+	return targets
+}
+
+// deleteDialogKeys enables the keys that apply to the current stage.
+func (m Model) deleteDialogKeys() deleteKeyMap {
+	keys := m.deleteKeys
+	choosing := m.deleteStage == deleteChooseTarget
+
+	keys.MoveTasks.SetEnabled(!choosing && len(m.moveTargets()) > 0)
+	keys.DeleteTasks.SetEnabled(!choosing)
+	keys.NextTarget.SetEnabled(choosing)
+	keys.PrevTarget.SetEnabled(choosing)
+	keys.ConfirmTarget.SetEnabled(choosing)
+
+	if choosing {
+		keys.Cancel.SetHelp("esc", "back")
+	}
+
+	return keys
+}
+
+func (m Model) renderDeleteDialog() string {
+	name := m.pendingDelete.Name
+	count := pluralTasks(len(m.pendingTasks))
+
+	lines := []string{
+		styles.InputLabelStyle.Render(fmt.Sprintf("%q has %s.", name, count)),
+	}
+
+	if m.deleteStage == deleteChooseTarget {
+		targets := m.moveTargets()
+		tabs := make([]string, len(targets))
+
+		for idx, fa := range targets {
+			style := inactiveTargetStyle
+			if idx == m.targetIdx {
+				style = activeTargetStyle
+			}
+
+			tabs[idx] = style.Render(fa.Name)
+		}
+
+		lines = append(lines, fmt.Sprintf("Move %s to:", count), lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
+	} else {
+		lines = append(lines, fmt.Sprintf("Move them to another focus area or delete them with %q?", name))
+
+		if len(m.moveTargets()) == 0 {
+			lines = append(lines, styles.InputHelpStyle.Render("This is the only focus area, so the tasks cannot be moved."))
+		}
+	}
+
+	lines = append(lines, "", m.helpBar.View(m.teaList.Help, m.deleteDialogKeys()))
+
+	return dialogStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// deleteDoneMsg reports how deleting a focus area in the background went. The
+// focus area is kept if any of its tasks failed.
+type deleteDoneMsg struct {
+	focusArea soqapi.FocusAreaDTO
+	disposal  taskDisposal
+	target    soqapi.FocusAreaDTO
+	tasks     []soqapi.TaskDTO
+	results   []bulk.Result[soqapi.TaskDTO]
+	err       error
+
+	// meta and areaMeta are the local details from before the deletion, so
+	// that undo can restore them.
+	meta     map[uint]store.TaskMeta
+	areaMeta map[uint]store.FocusAreaMeta
+}
+
+// deleteFocusArea deletes the pending focus area in the background after
+// moving or deleting its open tasks.
+func (m Model) deleteFocusArea(disposal taskDisposal, target soqapi.FocusAreaDTO) (Model, tea.Cmd) {
+	if m.deleting {
+		return m, nil
+	}
+
+	done := deleteDoneMsg{
+		focusArea: m.pendingDelete,
+		disposal:  disposal,
+		target:    target,
+		tasks:     m.pendingTasks,
+	}
+
+	if disposal == keepTasks {
+		done.tasks = nil
+	}
+
+	var err error
+
+	done.meta, err = m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	done.areaMeta, err = m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
+	m.deleting = true
+	statusCmd := m.teaList.NewStatusMessage(fmt.Sprintf("Deleting %q…", done.focusArea.Name))
+
+	run := func() tea.Msg {
+		done.results = bulk.Run(context.Background(), done.tasks, bulk.DefaultLimit, func(ctx context.Context, task soqapi.TaskDTO) error {
+			ctx, cancel := context.WithTimeout(ctx, common.DefaultRequestTimeout)
+			defer cancel()
+
+			if disposal == moveTasks {
+				return taskops.Move(ctx, m.client, m.store, m.logger, task, target.ID)
+			}
+
+			if err := m.client.DeleteTask(ctx, task.ID); err != nil {
+				return err
+			}
+
+			if err := m.store.DeleteTaskMeta(task.ID); err != nil {
+				m.logger.Error("Error deleting task metadata", "error", err)
+			}
+
+			return nil
+		})
+
+		if len(bulk.Failed(done.results)) > 0 {
+			return done
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+		defer cancel()
+
+		done.err = m.client.DeleteFocusArea(ctx, done.focusArea.ID)
+
+		return done
+	}
+
+	return m, tea.Batch(statusCmd, run)
+}
+
+func (m Model) onDeleteDone(msg deleteDoneMsg) (Model, tea.Cmd) {
+	m.deleting = false
+
+	handled := make([]soqapi.TaskDTO, 0, len(msg.results))
+	for _, result := range msg.results {
+		if result.Err == nil {
+			handled = append(handled, result.Item)
+		}
+	}
+
+	// The focus area is kept when any task or the focus area itself failed,
+	// so the tasks that were already moved or deleted can be put back in it.
+	if failed := bulk.Failed(msg.results); len(failed) > 0 {
+		for _, result := range failed {
+			m.logger.Error("Error handling task of deleted focus area", "task", result.Item.ID, "error", result.Err)
+		}
+
+		message := fmt.Sprintf("%d of %s failed, so %q was kept", len(failed), pluralTasks(len(msg.tasks)), msg.focusArea.Name)
+
+		m, refreshCmd := m.refreshFocusAreas()
+
+		if len(handled) == 0 {
+			return m, tea.Batch(refreshCmd, m.teaList.NewStatusMessage(message))
+		}
+
+		var undoCmd tea.Cmd
+		m.undo, undoCmd = m.undo.Push(message+"; "+handledSummary(msg, len(handled)), func(ctx context.Context) error {
+			return m.restoreTasks(ctx, msg, handled, msg.focusArea.ID)
+		})
+		m.resizeList()
+
+		return m, tea.Batch(refreshCmd, undoCmd)
+	}
+
+	if msg.err != nil {
+		if len(handled) == 0 {
+			return m, common.NewErrorMsg(fmt.Errorf("failed to delete focus area: %w", msg.err))
+		}
+
+		// An error here would replace the view and hide the undo, so it is
+		// reported in the status instead.
+		m.logger.Error("Error deleting focus area", "focusArea", msg.focusArea.ID, "error", msg.err)
+
+		m, refreshCmd := m.refreshFocusAreas()
+
+		var undoCmd tea.Cmd
+		m.undo, undoCmd = m.undo.Push(fmt.Sprintf("Failed to delete %q; %s", msg.focusArea.Name, handledSummary(msg, len(handled))), func(ctx context.Context) error {
+			return m.restoreTasks(ctx, msg, handled, msg.focusArea.ID)
+		})
+		m.resizeList()
+
+		return m, tea.Batch(refreshCmd, undoCmd)
+	}
+
+	if err := m.store.DeleteFocusAreaMeta(msg.focusArea.ID); err != nil {
+		m.logger.Error("Error deleting focus area metadata", "error", err)
+	}
+
+	message := "Focus area deleted"
+	switch {
+	case msg.disposal == moveTasks && len(msg.tasks) > 0:
+		message = fmt.Sprintf("Focus area deleted, %s moved to %s", pluralTasks(len(msg.tasks)), msg.target.Name)
+	case msg.disposal == deleteTasks && len(msg.tasks) > 0:
+		message = fmt.Sprintf("Focus area and %s deleted", pluralTasks(len(msg.tasks)))
+	}
+
+	// The API has no way to restore a focus area, so undo creates one with
+	// the same name and puts the tasks back in it. Time windows are not
+	// restored.
+	var undoCmd tea.Cmd
+	m.undo, undoCmd = m.undo.Push(message, func(ctx context.Context) error {
+		dto := soqapi.CreateFocusAreaRequestDTO{Name: msg.focusArea.Name}
+
+		restored, err := m.client.CreateFocusArea(ctx, &dto)
+		if err != nil {
+			return err
+		}
+
+		if err := m.store.SaveFocusAreaMeta(restored.ID, msg.areaMeta[msg.focusArea.ID]); err != nil {
+			m.logger.Error("Error restoring focus area metadata", "error", err)
+		}
+
+		return m.restoreTasks(ctx, msg, msg.tasks, restored.ID)
+	})
+
+	m, refreshCmd := m.refreshFocusAreas()
+	m.resizeList()
+
+	return m, tea.Batch(refreshCmd, undoCmd)
+}
+
+// restoreTasks puts tasks that were moved or deleted with a focus area back in
+// focusAreaID.
+func (m Model) restoreTasks(ctx context.Context, msg deleteDoneMsg, tasks []soqapi.TaskDTO, focusAreaID uint) error {
+	results := bulk.Run(ctx, tasks, bulk.DefaultLimit, func(ctx context.Context, task soqapi.TaskDTO) error {
+		if msg.disposal == moveTasks {
+			return taskops.Move(ctx, m.client, m.store, m.logger, task, focusAreaID)
+		}
+
+		return taskops.Recreate(ctx, m.client, m.store, m.logger, task, focusAreaID, msg.meta[task.ID])
+	})
+
+	errs := make([]error, 0)
+	for _, result := range bulk.Failed(results) {
+		errs = append(errs, fmt.Errorf("#%d %s: %w", result.Item.ID, result.Item.Summary, result.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// handledSummary says what happened to the tasks that were moved or deleted
+// before the focus area was kept.
+func handledSummary(msg deleteDoneMsg, count int) string {
+	if msg.disposal == moveTasks {
+		return fmt.Sprintf("%s moved to %s", pluralTasks(count), msg.target.Name)
+	}
+
+	return fmt.Sprintf("%s deleted", pluralTasks(count))
+}
+
+func pluralTasks(count int) string {
+	if count == 1 {
+		return "1 open task"
+	}
+
+	return fmt.Sprintf("%d open tasks", count)
+}
@@ -2,18 +2,37 @@ package focusarealist
 
 import (
 	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
 )
 
 type FocusAreaListItem struct {
 	focusArea soqapi.FocusAreaDTO
+	meta      store.FocusAreaMeta
+	openTasks int
 }
 
 func (f FocusAreaListItem) Title() string {
-	return f.focusArea.Name
+	if f.meta.Glyph == "" {
+		return f.focusArea.Name
+	}
+
+	return f.meta.Glyph + " " + f.focusArea.Name
 }
 
 func (f FocusAreaListItem) Description() string {
-	return ""
+	count := "No open tasks"
+	if f.openTasks > 0 {
+		count = pluralTasks(f.openTasks)
+	}
+
+	if f.meta.Color == "" {
+		return count
+	}
+
+	// The swatch carries its own color, so it goes last to keep the count in
+	// the delegate's style.
+	return count + " " + styles.FocusAreaBadge("", "", f.meta.Color)
 }
 
 func (f FocusAreaListItem) FilterValue() string {
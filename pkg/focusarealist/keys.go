@@ -9,6 +9,7 @@ type keyMap struct {
 	New    key.Binding
 	Edit   key.Binding
 	Delete key.Binding
+	Undo   key.Binding
 }
 
 func newKeyMap() keyMap {
@@ -29,5 +30,57 @@ func newKeyMap() keyMap {
 			key.WithKeys("d"),
 			key.WithHelp("d", "delete focus area"),
 		),
+		Undo: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "undo"),
+		),
+	}
+}
+
+// deleteKeyMap holds the keys of the dialog shown when deleting a focus area
+// that still has open tasks.
+type deleteKeyMap struct {
+	MoveTasks     key.Binding
+	DeleteTasks   key.Binding
+	NextTarget    key.Binding
+	PrevTarget    key.Binding
+	ConfirmTarget key.Binding
+	Cancel        key.Binding
+}
+
+func newDeleteKeyMap() deleteKeyMap {
+	return deleteKeyMap{
+		MoveTasks: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "move tasks"),
+		),
+		DeleteTasks: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "delete tasks too"),
+		),
+		NextTarget: key.NewBinding(
+			key.WithKeys("right", "l", "tab"),
+			key.WithHelp("→", "next"),
+		),
+		PrevTarget: key.NewBinding(
+			key.WithKeys("left", "h", "shift+tab"),
+			key.WithHelp("←", "previous"),
+		),
+		ConfirmTarget: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "move and delete"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "n"),
+			key.WithHelp("esc", "cancel"),
+		),
 	}
 }
+
+func (k deleteKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.MoveTasks, k.DeleteTasks, k.PrevTarget, k.NextTarget, k.ConfirmTarget, k.Cancel}
+}
+
+func (k deleteKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
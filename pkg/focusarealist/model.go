@@ -8,24 +8,54 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	soqapi "github.com/mole-squad/soq-api/api"
 	"github.com/mole-squad/soq-tui/pkg/api"
 	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/confirm"
 	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+	"github.com/mole-squad/soq-tui/pkg/undo"
 )
 
 type Model struct {
 	client *api.Client
 	logger *logger.Logger
+	store  *store.Store
 
 	keys    keyMap
 	teaList list.Model
+
+	focusAreas []soqapi.FocusAreaDTO
+	tasks      []soqapi.TaskDTO
+
+	confirm       confirm.Model
+	pendingDelete soqapi.FocusAreaDTO
+	pendingTasks  []soqapi.TaskDTO
+	deleteStage   deleteStage
+	deleteKeys    deleteKeyMap
+	targetIdx     int
+	deleting      bool
+	undo          undo.Stack
+
+	delegate mouse.ListDelegate
+	helpBar  mouse.HelpBar
+	clicks   mouse.ClickTracker
+
+	height int
+	width  int
 }
 
-func New(logger *logger.Logger, client *api.Client) common.AppView {
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
 	listKeys := newKeyMap()
 
-	teaList := list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+	delegate := mouse.NewListDelegate(list.NewDefaultDelegate())
+
+	teaList := list.New([]list.Item{}, delegate, 0, 0)
 	teaList.Title = "Focus Areas"
+	teaList.SetShowHelp(false)
 
 	teaList.AdditionalShortHelpKeys = func() []key.Binding {
 		return []key.Binding{
@@ -42,14 +72,20 @@ func New(logger *logger.Logger, client *api.Client) common.AppView {
 			listKeys.New,
 			listKeys.Edit,
 			listKeys.Delete,
+			listKeys.Undo,
 		}
 	}
 
 	return Model{
-		client:  client,
-		logger:  logger,
-		keys:    listKeys,
-		teaList: teaList,
+		client:     client,
+		logger:     logger,
+		store:      store,
+		confirm:    confirm.New(),
+		deleteKeys: newDeleteKeyMap(),
+		keys:       listKeys,
+		teaList:    teaList,
+		delegate:   delegate,
+		helpBar:    mouse.NewHelpBar(),
 	}
 }
 
@@ -61,10 +97,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case tea.WindowSizeMsg:
-		m.teaList.SetSize(msg.Width, msg.Height)
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resizeList()
 
 	case tea.KeyMsg:
 		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+
+	case deleteDoneMsg:
+		return m.onDeleteDone(msg)
+	}
+
+	if undo.IsExpireMsg(msg) {
+		m.undo = m.undo.Update(msg)
+		m.resizeList()
+
+		return m, nil
 	}
 
 	var cmd tea.Cmd
@@ -74,7 +125,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) View() string {
-	return m.teaList.View()
+	return lipgloss.JoinVertical(lipgloss.Left, m.teaList.View(), m.renderHelp())
 }
 
 func (m Model) Blur() (tea.Model, tea.Cmd) {
@@ -86,6 +137,14 @@ func (m Model) Focus() (tea.Model, tea.Cmd) {
 }
 
 func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.confirm.IsOpen() {
+		return m.onConfirmMsg(msg)
+	}
+
+	if m.deleteStage != deleteIdle {
+		return m.onDeleteDialogMsg(msg)
+	}
+
 	switch {
 	case key.Matches(msg, m.keys.Back):
 		return m, common.AppStateCmd(common.AppStateSettings)
@@ -101,14 +160,65 @@ func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 	case key.Matches(msg, m.keys.Delete):
 		return m.onDelete()
+
+	case key.Matches(msg, m.keys.Undo):
+		return m.onUndo()
 	}
 
 	var cmd tea.Cmd
 	m.teaList, cmd = m.teaList.Update(msg)
+	m.resizeList()
 
 	return m, cmd
 }
 
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if m.confirm.IsOpen() {
+		return m.onConfirmMsg(msg)
+	}
+
+	if m.deleteStage != deleteIdle {
+		return m.onDeleteDialogMsg(msg)
+	}
+
+	if keyMsg, ok := m.helpBar.Clicked(m.teaList, msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	if m.teaList.SettingFilter() {
+		return m, nil
+	}
+
+	idx := m.delegate.HandleMouse(&m.teaList, msg)
+	if idx >= 0 && m.clicks.Click(fmt.Sprint(idx)) {
+		return m.onEdit()
+	}
+
+	return m, nil
+}
+
+func (m *Model) resizeList() {
+	m.teaList.SetSize(m.width, m.height-lipgloss.Height(m.renderHelp()))
+}
+
+func (m Model) renderHelp() string {
+	if m.confirm.IsOpen() {
+		return m.teaList.Styles.HelpStyle.Render(m.confirm.View())
+	}
+
+	if m.deleteStage != deleteIdle {
+		return m.teaList.Styles.HelpStyle.Render(m.renderDeleteDialog())
+	}
+
+	help := m.teaList.Styles.HelpStyle.Render(m.helpBar.View(m.teaList.Help, m.teaList))
+
+	if toast := m.undo.View(m.keys.Undo.Help().Key); toast != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, m.teaList.Styles.HelpStyle.Render(toast), help)
+	}
+
+	return help
+}
+
 func (m Model) refreshFocusAreas() (Model, tea.Cmd) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -118,9 +228,24 @@ func (m Model) refreshFocusAreas() (Model, tea.Cmd) {
 		return m, common.NewErrorMsg(fmt.Errorf("error fetching focus areas: %w", err))
 	}
 
+	tasks, err := m.client.ListTasks(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching tasks: %w", err))
+	}
+
+	areaMeta, err := m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
+	m.focusAreas = focusAreas
+	m.tasks = tasks
+
+	counts := taskquery.CountByFocusArea(tasks)
+
 	newItems := make([]list.Item, len(focusAreas))
 	for i, fa := range focusAreas {
-		newItems[i] = FocusAreaListItem{focusArea: fa}
+		newItems[i] = FocusAreaListItem{focusArea: fa, meta: areaMeta[fa.ID], openTasks: counts[fa.ID]}
 	}
 
 	return m, m.teaList.SetItems(newItems)
@@ -144,6 +269,10 @@ func (m Model) onEdit() (Model, tea.Cmd) {
 }
 
 func (m Model) onDelete() (Model, tea.Cmd) {
+	if m.deleting {
+		return m, nil
+	}
+
 	selected := m.teaList.SelectedItem()
 	if selected == nil {
 		return m, common.NewErrorMsg(fmt.Errorf("no focus area selected"))
@@ -154,10 +283,51 @@ func (m Model) onDelete() (Model, tea.Cmd) {
 		return m, common.NewErrorMsg(fmt.Errorf("unexpected focus area item type"))
 	}
 
-	err := m.client.DeleteFocusArea(context.Background(), focusAreaItem.focusArea.ID)
+	m.pendingDelete = focusAreaItem.focusArea
+	m.pendingTasks = taskquery.FilterByFocusArea(m.tasks, focusAreaItem.focusArea.ID)
+
+	if len(m.pendingTasks) > 0 {
+		m.deleteStage = deleteChooseAction
+		m.resizeList()
+
+		return m, nil
+	}
+
+	prefs, err := m.store.LoadPrefs()
 	if err != nil {
-		return m, common.NewErrorMsg(fmt.Errorf("failed to delete focus area: %w", err))
+		m.logger.Error("Error loading preferences", "error", err)
 	}
 
-	return m.refreshFocusAreas()
+	if !prefs.ShouldConfirm(store.ConfirmDeleteFocusArea) {
+		return m.deleteFocusArea(keepTasks, soqapi.FocusAreaDTO{})
+	}
+
+	m.confirm = m.confirm.Ask(fmt.Sprintf("Delete focus area %q?", focusAreaItem.focusArea.Name))
+	m.resizeList()
+
+	return m, nil
+}
+
+func (m Model) onUndo() (Model, tea.Cmd) {
+	var (
+		undoFunc undo.Func
+		ok       bool
+	)
+
+	m.undo, undoFunc, ok = m.undo.Pop()
+	if !ok {
+		return m, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	if err := undoFunc(ctx); err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("failed to undo: %w", err))
+	}
+
+	m, cmd := m.refreshFocusAreas()
+	m.resizeList()
+
+	return m, cmd
 }
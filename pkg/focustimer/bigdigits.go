@@ -0,0 +1,44 @@
+package focustimer
+
+import "strings"
+
+const bigDigitHeight = 5
+
+// bigGlyphs are the characters of the countdown drawn with block characters.
+var bigGlyphs = map[rune][bigDigitHeight]string{
+	'0': {"█████", "█   █", "█   █", "█   █", "█████"},
+	'1': {"  █  ", " ██  ", "  █  ", "  █  ", " ███ "},
+	'2': {"█████", "    █", "█████", "█    ", "█████"},
+	'3': {"█████", "    █", " ████", "    █", "█████"},
+	'4': {"█   █", "█   █", "█████", "    █", "    █"},
+	'5': {"█████", "█    ", "█████", "    █", "█████"},
+	'6': {"█████", "█    ", "█████", "█   █", "█████"},
+	'7': {"█████", "    █", "   █ ", "  █  ", "  █  "},
+	'8': {"█████", "█   █", "█████", "█   █", "█████"},
+	'9': {"█████", "█   █", "█████", "    █", "█████"},
+	':': {"   ", " █ ", "   ", " █ ", "   "},
+}
+
+// renderBigText draws text such as "24:59" five lines tall. Characters without
+// a glyph are skipped.
+func renderBigText(text string) string {
+	var rows [bigDigitHeight][]string
+
+	for _, r := range text {
+		glyph, ok := bigGlyphs[r]
+		if !ok {
+			continue
+		}
+
+		for row := range rows {
+			rows[row] = append(rows[row], glyph[row])
+		}
+	}
+
+	lines := make([]string, bigDigitHeight)
+	for row := range rows {
+		lines[row] = strings.Join(rows[row], " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
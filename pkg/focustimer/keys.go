@@ -0,0 +1,47 @@
+package focustimer
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Toggle   key.Binding
+	Reset    key.Binding
+	Skip     key.Binding
+	Back     key.Binding
+	ShowHelp key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Toggle: key.NewBinding(
+			key.WithKeys(" ", "p"),
+			key.WithHelp("space", "start / pause"),
+		),
+		Reset: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "restart"),
+		),
+		Skip: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "skip to next"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "task list"),
+		),
+		ShowHelp: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
+	}
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Toggle, k.Reset, k.Skip, k.Back}
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Toggle, k.Reset, k.Skip},
+		{k.Back, k.ShowHelp},
+	}
+}
@@ -0,0 +1,342 @@
+package focustimer
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+type phase int
+
+const (
+	phaseWork phase = iota
+	phaseBreak
+)
+
+func (p phase) String() string {
+	if p == phaseBreak {
+		return "break"
+	}
+
+	return "work"
+}
+
+// tickMsg carries the ID of the countdown that scheduled it, so ticks from a
+// paused or replaced countdown are dropped.
+type tickMsg struct {
+	id int64
+}
+
+var lastTickID atomic.Int64
+
+var (
+	titleStyle = list.DefaultStyles().Title
+
+	summaryStyle = lipgloss.NewStyle().Bold(true)
+
+	workStyle  = lipgloss.NewStyle().Foreground(styles.HotPink)
+	breakStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#5FD75F"))
+
+	stateStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+)
+
+// Model counts down alternating work and break periods for a task. The
+// countdown keeps running while other views are shown.
+type Model struct {
+	logger *logger.Logger
+	store  *store.Store
+
+	task    soqapi.TaskDTO
+	hasTask bool
+
+	phase     phase
+	remaining time.Duration
+	deadline  time.Time
+	running   bool
+	tickID    int64
+
+	// startedAt is when the current work period was first started.
+	startedAt time.Time
+	sessions  int
+
+	keys    keyMap
+	help    help.Model
+	helpBar mouse.HelpBar
+
+	height int
+	width  int
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	return Model{
+		logger:  logger,
+		store:   store,
+		keys:    newKeyMap(),
+		help:    help.New(),
+		helpBar: mouse.NewHelpBar(),
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.Width = msg.Width
+
+	case common.FocusTaskMsg:
+		return m.focusTask(msg.Task)
+
+	case tickMsg:
+		return m.onTick(msg)
+
+	case tea.KeyMsg:
+		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		if keyMsg, ok := m.helpBar.Clicked(m.keys, msg); ok {
+			return m.onKeyMsg(keyMsg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) Blur() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+func (m Model) Focus() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m, common.AppStateCmd(common.AppStateTaskList)
+
+	case key.Matches(msg, m.keys.ShowHelp):
+		m.help.ShowAll = !m.help.ShowAll
+		return m, nil
+	}
+
+	if !m.hasTask {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Toggle):
+		if m.running {
+			m.pause()
+			return m, nil
+		}
+
+		return m.start()
+
+	case key.Matches(msg, m.keys.Reset):
+		m.running = false
+		m.remaining = m.phaseDuration(m.phase)
+
+	case key.Matches(msg, m.keys.Skip):
+		m.running = false
+		return m.enterPhase(m.nextPhase(), false)
+	}
+
+	return m, nil
+}
+
+// focusTask starts a work period on task, replacing any running countdown.
+func (m Model) focusTask(task soqapi.TaskDTO) (Model, tea.Cmd) {
+	m.task = task
+	m.hasTask = true
+	m.sessions = m.countSessions(task.ID)
+	m.running = false
+
+	return m.enterPhase(phaseWork, true)
+}
+
+func (m Model) enterPhase(next phase, start bool) (Model, tea.Cmd) {
+	m.phase = next
+	m.remaining = m.phaseDuration(next)
+	m.startedAt = time.Time{}
+
+	if !start {
+		return m, nil
+	}
+
+	return m.start()
+}
+
+func (m Model) start() (Model, tea.Cmd) {
+	now := time.Now()
+
+	if m.phase == phaseWork && m.startedAt.IsZero() {
+		m.startedAt = now
+	}
+
+	m.running = true
+	m.deadline = now.Add(m.remaining)
+	m.tickID = lastTickID.Add(1)
+
+	return m, tick(m.tickID)
+}
+
+func (m *Model) pause() {
+	m.remaining = time.Until(m.deadline)
+	m.running = false
+}
+
+func (m Model) onTick(msg tickMsg) (Model, tea.Cmd) {
+	if !m.running || msg.id != m.tickID {
+		return m, nil
+	}
+
+	m.remaining = time.Until(m.deadline)
+	if m.remaining > 0 {
+		return m, tick(m.tickID)
+	}
+
+	return m.completePhase()
+}
+
+// completePhase logs a finished work period and moves on. Breaks start on
+// their own; the next work period waits to be started.
+func (m Model) completePhase() (Model, tea.Cmd) {
+	finished := m.phase
+	notifyCmd := m.notify(finished)
+
+	if finished == phaseWork {
+		session := store.FocusSession{
+			TaskID:    m.task.ID,
+			Summary:   m.task.Summary,
+			StartedAt: m.startedAt,
+			EndedAt:   time.Now(),
+		}
+
+		if err := m.store.RecordFocusSession(session); err != nil {
+			m.logger.Error("Error recording focus session", "error", err)
+		}
+
+		m.sessions = m.countSessions(m.task.ID)
+	}
+
+	m.running = false
+
+	m, phaseCmd := m.enterPhase(m.nextPhase(), finished == phaseWork)
+
+	return m, tea.Batch(notifyCmd, phaseCmd)
+}
+
+func (m Model) nextPhase() phase {
+	if m.phase == phaseWork {
+		return phaseBreak
+	}
+
+	return phaseWork
+}
+
+func (m Model) phaseDuration(p phase) time.Duration {
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	if p == phaseBreak {
+		return prefs.BreakDuration()
+	}
+
+	return prefs.WorkDuration()
+}
+
+func (m Model) countSessions(taskID uint) int {
+	sessions, err := m.store.LoadFocusSessions()
+	if err != nil {
+		m.logger.Error("Error loading focus sessions", "error", err)
+	}
+
+	return store.CountFocusSessions(sessions)[taskID]
+}
+
+func tick(id int64) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return tickMsg{id: id}
+	})
+}
+
+func (m Model) View() string {
+	help := m.helpBar.View(m.help, m.keys)
+	header := lipgloss.NewStyle().PaddingBottom(1).Render(titleStyle.Render("Focus"))
+
+	bodyHeight := m.height - lipgloss.Height(header) - lipgloss.Height(help)
+
+	body := stateStyle.Render("Select a task in the task list and press t to focus on it.")
+	if m.hasTask {
+		body = m.renderTimer()
+	}
+
+	body = lipgloss.Place(m.width, bodyHeight, lipgloss.Center, lipgloss.Center, body)
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, help)
+}
+
+func (m Model) renderTimer() string {
+	phaseStyle := workStyle
+	phaseLabel := "Work"
+
+	if m.phase == phaseBreak {
+		phaseStyle = breakStyle
+		phaseLabel = "Break"
+	}
+
+	state := "Paused · press space to continue"
+	switch {
+	case m.running:
+		state = "Running"
+	case m.remaining == m.phaseDuration(m.phase):
+		state = "Ready · press space to start"
+	}
+
+	sessions := "No sessions on this task yet"
+	if m.sessions == 1 {
+		sessions = "1 session on this task"
+	} else if m.sessions > 1 {
+		sessions = fmt.Sprintf("%d sessions on this task", m.sessions)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		summaryStyle.Render(m.task.Summary),
+		"",
+		phaseStyle.Render(phaseLabel),
+		"",
+		phaseStyle.Render(renderBigText(formatRemaining(m.remaining))),
+		"",
+		stateStyle.Render(state),
+		stateStyle.Render(sessions),
+	)
+}
+
+// formatRemaining renders a duration as minutes and seconds, rounding up so
+// the countdown reaches 00:00 as the period ends.
+func formatRemaining(d time.Duration) string {
+	seconds := int((max(d, 0) + time.Second - 1) / time.Second)
+
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,37 @@
+package focustimer
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mole-squad/soq-tui/pkg/utils"
+)
+
+// notify rings the terminal bell when a phase ends and runs the notification
+// command from the preferences, if any.
+func (m Model) notify(finished phase) tea.Cmd {
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	task := m.task.Summary
+
+	return func() tea.Msg {
+		// The bell goes to stderr so it cannot interleave with the frames
+		// written to stdout.
+		fmt.Fprint(os.Stderr, "\a")
+
+		if prefs.FocusNotifyCommand == "" {
+			return nil
+		}
+
+		err := utils.RunHook(prefs.FocusNotifyCommand, "SOQ_TASK="+task, "SOQ_PHASE="+finished.String())
+		if err != nil {
+			m.logger.Error("Error running focus notification command", "error", err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,268 @@
+package forms
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const formTagKey = "form"
+
+// FieldErrors maps form field IDs to the error raised while handling that field.
+type FieldErrors map[string]error
+
+func (e FieldErrors) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = fmt.Sprintf("%s: %s", id, e[id])
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Bind copies form values into the struct pointed to by dest. Struct fields are
+// matched to field IDs by their `form` tag, falling back to the `json` tag name
+// so API DTOs can be bound directly.
+func Bind(values map[string]any, dest any) error {
+	destVal := reflect.ValueOf(dest)
+	if destVal.Kind() != reflect.Pointer || destVal.IsNil() {
+		return fmt.Errorf("bind destination must be a non-nil pointer, got %T", dest)
+	}
+
+	structVal := destVal.Elem()
+	if structVal.Kind() != reflect.Struct {
+		return fmt.Errorf("bind destination must point to a struct, got %T", dest)
+	}
+
+	errs := make(FieldErrors)
+	structType := structVal.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		fieldID, ok := bindingName(structField)
+		if !ok {
+			continue
+		}
+
+		value, ok := values[fieldID]
+		if !ok {
+			continue
+		}
+
+		if err := assignValue(structVal.Field(i), value); err != nil {
+			errs[fieldID] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValuesOf is the inverse of Bind. It reads the bindable fields of src, a struct
+// or pointer to struct, into a map keyed by field ID.
+func ValuesOf(src any) map[string]any {
+	values := make(map[string]any)
+
+	srcVal := reflect.Indirect(reflect.ValueOf(src))
+	if srcVal.Kind() != reflect.Struct {
+		return values
+	}
+
+	srcType := srcVal.Type()
+
+	for i := 0; i < srcType.NumField(); i++ {
+		structField := srcType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		fieldID, ok := bindingName(structField)
+		if !ok {
+			continue
+		}
+
+		values[fieldID] = srcVal.Field(i).Interface()
+	}
+
+	return values
+}
+
+func bindingName(field reflect.StructField) (string, bool) {
+	for _, tagKey := range []string{formTagKey, "json"} {
+		tag, ok := field.Tag.Lookup(tagKey)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false
+		}
+
+		if name != "" {
+			return name, true
+		}
+	}
+
+	return field.Name, true
+}
+
+func assignValue(dst reflect.Value, value any) error {
+	if value == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	src := reflect.ValueOf(value)
+
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	if src.Kind() == reflect.String {
+		return assignString(dst, src.String())
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(formatValue(value))
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return assignNumber(dst, src)
+	}
+
+	if src.Type().ConvertibleTo(dst.Type()) {
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("cannot bind %T to %s", value, dst.Type())
+}
+
+func assignString(dst reflect.Value, str string) error {
+	str = strings.TrimSpace(str)
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(str)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(str, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", str)
+		}
+
+		dst.SetInt(parsed)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(str, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer %q", str)
+		}
+
+		dst.SetUint(parsed)
+
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(str, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q", str)
+		}
+
+		dst.SetFloat(parsed)
+
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(str)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", str)
+		}
+
+		dst.SetBool(parsed)
+
+	default:
+		return fmt.Errorf("cannot bind string to %s", dst.Type())
+	}
+
+	return nil
+}
+
+func assignNumber(dst reflect.Value, src reflect.Value) error {
+	var asFloat float64
+
+	switch src.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		asFloat = float64(src.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		asFloat = float64(src.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		asFloat = src.Float()
+
+	default:
+		return fmt.Errorf("cannot bind %s to %s", src.Type(), dst.Type())
+	}
+
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if asFloat != float64(int64(asFloat)) || dst.OverflowInt(int64(asFloat)) {
+			return fmt.Errorf("value %v out of range for %s", src, dst.Type())
+		}
+
+		dst.SetInt(int64(asFloat))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if asFloat < 0 || asFloat != float64(uint64(asFloat)) || dst.OverflowUint(uint64(asFloat)) {
+			return fmt.Errorf("value %v out of range for %s", src, dst.Type())
+		}
+
+		dst.SetUint(uint64(asFloat))
+
+	default:
+		if dst.OverflowFloat(asFloat) {
+			return fmt.Errorf("value %v out of range for %s", src, dst.Type())
+		}
+
+		dst.SetFloat(asFloat)
+	}
+
+	return nil
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+
+	case string:
+		return v
+
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	return fmt.Sprint(value)
+}
+
+func valuesEqual(a, b any) bool {
+	return formatValue(a) == formatValue(b)
+}
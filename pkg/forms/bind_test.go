@@ -0,0 +1,273 @@
+package forms
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type level string
+
+type named struct{ name string }
+
+func (n named) String() string { return n.name }
+
+type bindTarget struct {
+	Title    string  `form:"title" json:"summary"`
+	Notes    string  `json:"notes,omitempty"`
+	Area     uint    `form:",omitempty" json:"focusAreaId"`
+	Count    int     `json:"count"`
+	Ratio    float64 `json:"ratio"`
+	Done     bool    `json:"done"`
+	Level    level   `json:"level"`
+	Due      *string `json:"due"`
+	Internal string  `form:"-" json:"internal"`
+	Skipped  string  `json:"-"`
+	Plain    string
+	hidden   string
+}
+
+func TestBind(t *testing.T) {
+	due := "2026-10-21"
+
+	tests := []struct {
+		name   string
+		values map[string]any
+		start  bindTarget
+		want   bindTarget
+	}{
+		{
+			name:   "form tag wins over the json tag",
+			values: map[string]any{"title": "Write report", "summary": "ignored"},
+			want:   bindTarget{Title: "Write report"},
+		},
+		{
+			name:   "json tag name is used without a form tag name",
+			values: map[string]any{"notes": "Some notes", "focusAreaId": uint(3)},
+			want:   bindTarget{Notes: "Some notes", Area: 3},
+		},
+		{
+			name:   "field name is used without tags",
+			values: map[string]any{"Plain": "plain"},
+			want:   bindTarget{Plain: "plain"},
+		},
+		{
+			name:   "dash tags and unexported fields are skipped",
+			values: map[string]any{"internal": "x", "Internal": "x", "Skipped": "x", "-": "x", "hidden": "x"},
+			start:  bindTarget{Internal: "kept", Skipped: "kept"},
+			want:   bindTarget{Internal: "kept", Skipped: "kept"},
+		},
+		{
+			name:   "missing values leave fields alone",
+			values: map[string]any{"count": 2},
+			start:  bindTarget{Title: "Kept", Count: 1},
+			want:   bindTarget{Title: "Kept", Count: 2},
+		},
+		{
+			name:   "zero values overwrite fields",
+			values: map[string]any{"title": "", "count": 0, "done": false},
+			start:  bindTarget{Title: "Old", Count: 5, Done: true},
+			want:   bindTarget{},
+		},
+		{
+			name:   "nil resets a field to its zero value",
+			values: map[string]any{"due": nil, "title": nil},
+			start:  bindTarget{Title: "Old", Due: &due},
+			want:   bindTarget{},
+		},
+		{
+			name:   "pointer values are assigned",
+			values: map[string]any{"due": &due},
+			want:   bindTarget{Due: &due},
+		},
+		{
+			name:   "strings are parsed into the field type",
+			values: map[string]any{"count": " 42 ", "focusAreaId": "7", "ratio": "0.5", "done": "true", "level": "high"},
+			want:   bindTarget{Count: 42, Area: 7, Ratio: 0.5, Done: true, Level: "high"},
+		},
+		{
+			name:   "numbers are converted between kinds",
+			values: map[string]any{"count": 3.0, "focusAreaId": int64(4), "ratio": 2},
+			want:   bindTarget{Count: 3, Area: 4, Ratio: 2},
+		},
+		{
+			name:   "non-strings are formatted into string fields",
+			values: map[string]any{"title": named{"Stringer"}, "notes": 12},
+			want:   bindTarget{Title: "Stringer", Notes: "12"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.start
+
+			if err := Bind(tt.values, &got); err != nil {
+				t.Fatalf("Bind returned error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bind = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindFieldErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]any
+		want   map[string]string
+	}{
+		{
+			name:   "unparsable strings",
+			values: map[string]any{"count": "many", "focusAreaId": "-1", "ratio": "half", "done": "maybe"},
+			want: map[string]string{
+				"count":       `invalid integer "many"`,
+				"focusAreaId": `invalid unsigned integer "-1"`,
+				"ratio":       `invalid number "half"`,
+				"done":        `invalid boolean "maybe"`,
+			},
+		},
+		{
+			name:   "empty string for a number",
+			values: map[string]any{"count": ""},
+			want:   map[string]string{"count": `invalid integer ""`},
+		},
+		{
+			name:   "numbers that do not fit",
+			values: map[string]any{"count": 1.5, "focusAreaId": -2},
+			want: map[string]string{
+				"count":       "value 1.5 out of range for int",
+				"focusAreaId": "value -2 out of range for uint",
+			},
+		},
+		{
+			name:   "mismatched types",
+			values: map[string]any{"done": 1, "due": "2026-10-21", "count": true},
+			want: map[string]string{
+				"done":  "cannot bind int to bool",
+				"due":   "cannot bind string to *string",
+				"count": "cannot bind bool to int",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var target bindTarget
+
+			err := Bind(tt.values, &target)
+
+			var fieldErrs FieldErrors
+			if !errors.As(err, &fieldErrs) {
+				t.Fatalf("Bind returned %v, want FieldErrors", err)
+			}
+
+			got := make(map[string]string, len(fieldErrs))
+			for id, err := range fieldErrs {
+				got[id] = err.Error()
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bind errors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindKeepsValidFields(t *testing.T) {
+	var target bindTarget
+
+	err := Bind(map[string]any{"title": "Write report", "count": "many"}, &target)
+	if err == nil {
+		t.Fatal("Bind returned no error")
+	}
+
+	if want := `count: invalid integer "many"`; err.Error() != want {
+		t.Errorf("Bind error = %q, want %q", err.Error(), want)
+	}
+
+	if target.Title != "Write report" {
+		t.Errorf("Title = %q, want the valid field to be bound", target.Title)
+	}
+}
+
+func TestBindDestination(t *testing.T) {
+	var target bindTarget
+	var nilTarget *bindTarget
+	notStruct := 1
+
+	tests := []struct {
+		name string
+		dest any
+		want string
+	}{
+		{"struct value", target, "bind destination must be a non-nil pointer, got forms.bindTarget"},
+		{"nil pointer", nilTarget, "bind destination must be a non-nil pointer, got *forms.bindTarget"},
+		{"pointer to non-struct", &notStruct, "bind destination must point to a struct, got *int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Bind(map[string]any{}, tt.dest)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("Bind error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldErrorsError(t *testing.T) {
+	err := FieldErrors{
+		"title": errors.New("is required"),
+		"count": errors.New("invalid integer"),
+	}
+
+	if want := "count: invalid integer; title: is required"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestValuesOf(t *testing.T) {
+	due := "2026-10-21"
+	src := bindTarget{
+		Title:    "Write report",
+		Area:     3,
+		Due:      &due,
+		Internal: "x",
+		Skipped:  "x",
+		hidden:   "x",
+	}
+
+	want := map[string]any{
+		"title":       "Write report",
+		"notes":       "",
+		"focusAreaId": uint(3),
+		"count":       0,
+		"ratio":       0.0,
+		"done":        false,
+		"level":       level(""),
+		"due":         &due,
+		"Plain":       "",
+	}
+
+	for _, value := range []any{src, &src} {
+		if got := ValuesOf(value); !reflect.DeepEqual(got, want) {
+			t.Errorf("ValuesOf(%T) = %v, want %v", value, got, want)
+		}
+	}
+
+	if got := ValuesOf(1); len(got) != 0 {
+		t.Errorf("ValuesOf(1) = %v, want no values", got)
+	}
+
+	var roundTrip bindTarget
+	if err := Bind(ValuesOf(src), &roundTrip); err != nil {
+		t.Fatalf("Bind returned error: %v", err)
+	}
+
+	src.Internal, src.Skipped, src.hidden = "", "", ""
+	if !reflect.DeepEqual(roundTrip, src) {
+		t.Errorf("Bind(ValuesOf) = %+v, want %+v", roundTrip, src)
+	}
+}
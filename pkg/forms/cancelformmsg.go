@@ -0,0 +1,15 @@
+package forms
+
+import tea "github.com/charmbracelet/bubbletea"
+
+type CancelFormMsg struct {
+	FormID string
+}
+
+func NewCancelFormCmd(formID string) tea.Cmd {
+	return func() tea.Msg {
+		return CancelFormMsg{
+			FormID: formID,
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package forms
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	teatextinput "github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/markdown"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+var (
+	checklistBoxStyle  = lipgloss.NewStyle().Foreground(styles.HotPink)
+	checklistDoneStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Strikethrough(true)
+)
+
+type checklistKeyMap struct {
+	NextItem   key.Binding
+	PrevItem   key.Binding
+	AddItem    key.Binding
+	ToggleItem key.Binding
+	MoveDown   key.Binding
+	MoveUp     key.Binding
+	DeleteItem key.Binding
+}
+
+func newChecklistKeyMap() checklistKeyMap {
+	return checklistKeyMap{
+		NextItem: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithHelp("↑/↓", "item"),
+		),
+		PrevItem: key.NewBinding(
+			key.WithKeys("up"),
+		),
+		AddItem: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "add item"),
+		),
+		ToggleItem: key.NewBinding(
+			key.WithKeys("ctrl+x"),
+			key.WithHelp("ctrl+x", "done / not done"),
+		),
+		MoveDown: key.NewBinding(
+			key.WithKeys("alt+down"),
+			key.WithHelp("alt+↑/↓", "reorder"),
+		),
+		MoveUp: key.NewBinding(
+			key.WithKeys("alt+up"),
+		),
+		DeleteItem: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "remove empty item"),
+		),
+	}
+}
+
+type checklistItem struct {
+	text string
+	done bool
+}
+
+// ChecklistInput edits an ordered list of items that can each be done. The
+// selected item is edited in place. Its value is the items as Markdown
+// checklist lines, such as "- [x] first\n- [ ] second"; items without text
+// are left out.
+type ChecklistInput struct {
+	id    string
+	label string
+
+	items  []checklistItem
+	cursor int
+
+	teaInput teatextinput.Model
+	focused  bool
+	keys     checklistKeyMap
+
+	zonePrefix string
+	width      int
+}
+
+func NewChecklistInput(id string, label string) FormField {
+	teaInput := teatextinput.New()
+	teaInput.Prompt = ""
+	teaInput.Placeholder = "Add an item"
+
+	return &ChecklistInput{
+		id:         id,
+		label:      label,
+		items:      []checklistItem{{}},
+		teaInput:   teaInput,
+		keys:       newChecklistKeyMap(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (c *ChecklistInput) Init() tea.Cmd {
+	return nil
+}
+
+func (c *ChecklistInput) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		c.teaInput, cmd = c.teaInput.Update(msg)
+
+		return c, cmd
+	}
+
+	switch {
+	case key.Matches(keyMsg, c.keys.NextItem):
+		c.saveInput()
+		c.selectItem(c.cursor + 1)
+
+	case key.Matches(keyMsg, c.keys.PrevItem):
+		c.saveInput()
+		c.selectItem(c.cursor - 1)
+
+	case key.Matches(keyMsg, c.keys.AddItem):
+		c.saveInput()
+		c.items = slices.Insert(c.items, c.cursor+1, checklistItem{})
+		c.selectItem(c.cursor + 1)
+
+	case key.Matches(keyMsg, c.keys.ToggleItem):
+		c.items[c.cursor].done = !c.items[c.cursor].done
+
+	case key.Matches(keyMsg, c.keys.MoveDown):
+		c.moveItem(1)
+
+	case key.Matches(keyMsg, c.keys.MoveUp):
+		c.moveItem(-1)
+
+	case key.Matches(keyMsg, c.keys.DeleteItem) && c.teaInput.Value() == "" && len(c.items) > 1:
+		c.items = slices.Delete(c.items, c.cursor, c.cursor+1)
+		c.selectItem(max(c.cursor-1, 0))
+
+	default:
+		var cmd tea.Cmd
+		c.teaInput, cmd = c.teaInput.Update(msg)
+		c.saveInput()
+
+		return c, cmd
+	}
+
+	return c, nil
+}
+
+// Click selects the clicked item, and ticks or unticks it when its box was
+// clicked.
+func (c *ChecklistInput) Click(msg tea.MouseMsg) {
+	if !mouse.IsLeftClick(msg) {
+		return
+	}
+
+	c.saveInput()
+
+	for idx := range c.items {
+		if zone.Get(c.boxZoneID(idx)).InBounds(msg) {
+			c.items[idx].done = !c.items[idx].done
+			c.selectItem(idx)
+
+			return
+		}
+
+		if zone.Get(c.itemZoneID(idx)).InBounds(msg) {
+			c.selectItem(idx)
+			return
+		}
+	}
+}
+
+func (c *ChecklistInput) View() string {
+	renderedLabel := ""
+	if c.label != "" {
+		renderedLabel = styles.InputLabelStyle.Render(c.label)
+	}
+
+	rows := make([]string, len(c.items))
+
+	for idx, item := range c.items {
+		box := "[ ]"
+		if item.done {
+			box = "[x]"
+		}
+
+		text := item.text
+		switch {
+		case c.focused && idx == c.cursor:
+			text = c.teaInput.View()
+		case item.done:
+			text = checklistDoneStyle.Render(text)
+		}
+
+		rows[idx] = zone.Mark(c.itemZoneID(idx), zone.Mark(c.boxZoneID(idx), checklistBoxStyle.Render(box))+" "+text)
+	}
+
+	if !c.focused {
+		if done, total := c.progress(); total > 0 {
+			rows = append(rows, styles.InputHelpStyle.Render(fmt.Sprintf("%d/%d done", done, total)))
+		}
+	}
+
+	frameWidth, _ := styles.InputStyle.GetFrameSize()
+	renderedInput := styles.InputStyle.
+		Width(c.width - frameWidth).
+		Render(strings.Join(rows, "\n"))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		renderedLabel,
+		renderedInput,
+	)
+}
+
+func (c *ChecklistInput) ViewSidePanel() string {
+	return ""
+}
+
+func (c *ChecklistInput) Blur() tea.Cmd {
+	c.focused = false
+	c.teaInput.Blur()
+
+	return nil
+}
+
+func (c *ChecklistInput) Focus() tea.Cmd {
+	c.focused = true
+
+	return c.teaInput.Focus()
+}
+
+func (c *ChecklistInput) HasPanelContent() bool {
+	return false
+}
+
+func (c *ChecklistInput) AcceptsText() bool {
+	return true
+}
+
+// CapturesKey claims enter, which adds an item instead of moving on.
+func (c *ChecklistInput) CapturesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, c.keys.AddItem)
+}
+
+func (c *ChecklistInput) HelpKeys() []key.Binding {
+	return []key.Binding{c.keys.NextItem, c.keys.AddItem, c.keys.ToggleItem, c.keys.MoveDown}
+}
+
+func (c *ChecklistInput) GetID() string {
+	return c.id
+}
+
+func (c *ChecklistInput) GetValue() any {
+	lines := make([]string, 0, len(c.items))
+
+	for _, item := range c.items {
+		text := strings.TrimSpace(item.text)
+		if text == "" {
+			continue
+		}
+
+		mark := " "
+		if item.done {
+			mark = "x"
+		}
+
+		lines = append(lines, fmt.Sprintf("- [%s] %s", mark, text))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (c *ChecklistInput) SetValue(value any) {
+	c.items = c.items[:0]
+
+	for _, item := range markdown.ParseChecklist(formatValue(value)) {
+		c.items = append(c.items, checklistItem{text: item.Text, done: item.Checked})
+	}
+
+	if len(c.items) == 0 {
+		c.items = append(c.items, checklistItem{})
+	}
+
+	c.selectItem(0)
+}
+
+func (c *ChecklistInput) SetSize(width int, height int) {
+	c.width = width
+
+	inputFrameWidth, _ := styles.InputStyle.GetFrameSize()
+
+	// Leave room for the box in front of the item.
+	c.teaInput.Width = width - inputFrameWidth - 4
+}
+
+func (c *ChecklistInput) SetPanelSize(width int, height int) {}
+
+// selectItem moves the cursor, keeping it on the list, and loads the item into
+// the text input. Edits to the previous item must be saved first.
+func (c *ChecklistInput) selectItem(idx int) {
+	c.cursor = min(max(idx, 0), len(c.items)-1)
+	c.teaInput.SetValue(c.items[c.cursor].text)
+	c.teaInput.CursorEnd()
+}
+
+func (c *ChecklistInput) saveInput() {
+	c.items[c.cursor].text = c.teaInput.Value()
+}
+
+func (c *ChecklistInput) moveItem(delta int) {
+	target := c.cursor + delta
+	if target < 0 || target >= len(c.items) {
+		return
+	}
+
+	c.saveInput()
+	c.items[c.cursor], c.items[target] = c.items[target], c.items[c.cursor]
+	c.selectItem(target)
+}
+
+func (c *ChecklistInput) progress() (int, int) {
+	done, total := 0, 0
+
+	for _, item := range c.items {
+		if strings.TrimSpace(item.text) == "" {
+			continue
+		}
+
+		total++
+		if item.done {
+			done++
+		}
+	}
+
+	return done, total
+}
+
+func (c *ChecklistInput) itemZoneID(idx int) string {
+	return fmt.Sprintf("%sitem-%d", c.zonePrefix, idx)
+}
+
+func (c *ChecklistInput) boxZoneID(idx int) string {
+	return fmt.Sprintf("%sbox-%d", c.zonePrefix, idx)
+}
@@ -0,0 +1,324 @@
+package forms
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+// DateFormat is the layout of DateInput values.
+const DateFormat = time.DateOnly
+
+var (
+	calendarTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(styles.HotPink)
+
+	calendarWeekdayStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+
+	calendarTodayStyle = lipgloss.NewStyle().
+				Foreground(styles.HotPink).
+				Underline(true)
+
+	calendarSelectedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFFFFF")).
+				Background(styles.HotPink)
+)
+
+type dateKeyMap struct {
+	NextDay   key.Binding
+	PrevDay   key.Binding
+	NextWeek  key.Binding
+	PrevWeek  key.Binding
+	NextMonth key.Binding
+	PrevMonth key.Binding
+	Today     key.Binding
+	Clear     key.Binding
+}
+
+func newDateKeyMap() dateKeyMap {
+	return dateKeyMap{
+		NextDay: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("←/→", "day"),
+		),
+		PrevDay: key.NewBinding(
+			key.WithKeys("left", "h"),
+		),
+		NextWeek: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↑/↓", "week"),
+		),
+		PrevWeek: key.NewBinding(
+			key.WithKeys("up", "k"),
+		),
+		NextMonth: key.NewBinding(
+			key.WithKeys("pgdown", "]"),
+			key.WithHelp("[/]", "month"),
+		),
+		PrevMonth: key.NewBinding(
+			key.WithKeys("pgup", "["),
+		),
+		Today: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "today"),
+		),
+		Clear: key.NewBinding(
+			key.WithKeys("x", "backspace", "delete"),
+			key.WithHelp("x", "no date"),
+		),
+	}
+}
+
+// DateInput picks a day from a calendar shown in the side panel. Its value is
+// the day formatted with DateFormat, or empty for no date.
+type DateInput struct {
+	id    string
+	label string
+
+	date    time.Time
+	hasDate bool
+	keys    dateKeyMap
+
+	zonePrefix string
+	width      int
+}
+
+func NewDateInput(id string, label string) FormField {
+	return &DateInput{
+		id:         id,
+		label:      label,
+		keys:       newDateKeyMap(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (d *DateInput) Init() tea.Cmd {
+	return nil
+}
+
+func (d *DateInput) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		d.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		d.Click(msg)
+	}
+
+	return d, nil
+}
+
+func (d *DateInput) onKeyMsg(msg tea.KeyMsg) {
+	if key.Matches(msg, d.keys.Clear) {
+		d.hasDate = false
+		return
+	}
+
+	navigation := []key.Binding{
+		d.keys.NextDay, d.keys.PrevDay, d.keys.NextWeek, d.keys.PrevWeek, d.keys.NextMonth, d.keys.PrevMonth,
+	}
+
+	// Moving from no date starts at today.
+	if key.Matches(msg, d.keys.Today) || (!d.hasDate && key.Matches(msg, navigation...)) {
+		d.date = today()
+		d.hasDate = true
+
+		return
+	}
+
+	switch {
+	case key.Matches(msg, d.keys.NextDay):
+		d.date = d.date.AddDate(0, 0, 1)
+	case key.Matches(msg, d.keys.PrevDay):
+		d.date = d.date.AddDate(0, 0, -1)
+	case key.Matches(msg, d.keys.NextWeek):
+		d.date = d.date.AddDate(0, 0, 7)
+	case key.Matches(msg, d.keys.PrevWeek):
+		d.date = d.date.AddDate(0, 0, -7)
+	case key.Matches(msg, d.keys.NextMonth):
+		d.date = addMonths(d.date, 1)
+	case key.Matches(msg, d.keys.PrevMonth):
+		d.date = addMonths(d.date, -1)
+	}
+}
+
+// Click selects the day under the pointer in the calendar.
+func (d *DateInput) Click(msg tea.MouseMsg) {
+	if !mouse.IsLeftClick(msg) {
+		return
+	}
+
+	month := d.shownMonth()
+
+	for day := month; day.Month() == month.Month(); day = day.AddDate(0, 0, 1) {
+		if zone.Get(d.dayZoneID(day)).InBounds(msg) {
+			d.date = day
+			d.hasDate = true
+
+			return
+		}
+	}
+}
+
+func (d *DateInput) View() string {
+	renderedLabel := ""
+	if d.label != "" {
+		renderedLabel = styles.InputLabelStyle.Render(d.label)
+	}
+
+	value := styles.InputHelpStyle.Render("None · press t for today")
+	if d.hasDate {
+		value = d.date.Format("Mon, Jan 2 2006") + styles.InputHelpStyle.Render(" · "+RelativeDay(d.date, today()))
+	}
+
+	frameWidth, _ := styles.InputStyle.GetFrameSize()
+	renderedInput := styles.InputStyle.
+		Width(d.width - frameWidth).
+		Render(value)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		renderedLabel,
+		renderedInput,
+	)
+}
+
+// ViewSidePanel renders the month of the selected day, or of today.
+func (d *DateInput) ViewSidePanel() string {
+	month := d.shownMonth()
+	now := today()
+
+	lines := []string{
+		calendarTitleStyle.Render(month.Format("January 2006")),
+		"",
+		calendarWeekdayStyle.Render("Mo Tu We Th Fr Sa Su"),
+	}
+
+	// Weeks start on Monday.
+	offset := (int(month.Weekday()) + 6) % 7
+	week := strings.Repeat("   ", offset)
+
+	for day := month; day.Month() == month.Month(); day = day.AddDate(0, 0, 1) {
+		cell := fmt.Sprintf("%2d", day.Day())
+
+		switch {
+		case d.hasDate && day.Equal(d.date):
+			cell = calendarSelectedStyle.Render(cell)
+		case day.Equal(now):
+			cell = calendarTodayStyle.Render(cell)
+		}
+
+		week += zone.Mark(d.dayZoneID(day), cell)
+
+		if day.Weekday() == time.Sunday {
+			lines = append(lines, week)
+			week = ""
+		} else {
+			week += " "
+		}
+	}
+
+	if week != "" {
+		lines = append(lines, week)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (d *DateInput) Blur() tea.Cmd {
+	return nil
+}
+
+func (d *DateInput) Focus() tea.Cmd {
+	return nil
+}
+
+func (d *DateInput) HasPanelContent() bool {
+	return true
+}
+
+func (d *DateInput) HelpKeys() []key.Binding {
+	return []key.Binding{d.keys.NextDay, d.keys.NextWeek, d.keys.NextMonth, d.keys.Today, d.keys.Clear}
+}
+
+func (d *DateInput) GetID() string {
+	return d.id
+}
+
+func (d *DateInput) GetValue() any {
+	if !d.hasDate {
+		return ""
+	}
+
+	return d.date.Format(DateFormat)
+}
+
+// SetValue selects the day in value. Values that are not dates clear the
+// input.
+func (d *DateInput) SetValue(value any) {
+	date, err := time.ParseInLocation(DateFormat, formatValue(value), time.Local)
+
+	d.date = date
+	d.hasDate = err == nil
+}
+
+func (d *DateInput) SetSize(width int, height int) {
+	d.width = width
+}
+
+func (d *DateInput) SetPanelSize(width int, height int) {}
+
+func (d *DateInput) shownMonth() time.Time {
+	shown := d.date
+	if !d.hasDate {
+		shown = today()
+	}
+
+	return time.Date(shown.Year(), shown.Month(), 1, 0, 0, 0, 0, time.Local)
+}
+
+func (d *DateInput) dayZoneID(day time.Time) string {
+	return d.zonePrefix + "day-" + day.Format(DateFormat)
+}
+
+// RelativeDay describes day relative to now, such as "tomorrow" or "in 3
+// days". Both are expected at midnight.
+func RelativeDay(day, now time.Time) string {
+	days := int(day.Sub(now).Round(24*time.Hour) / (24 * time.Hour))
+
+	switch {
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	case days == -1:
+		return "yesterday"
+	case days > 1:
+		return fmt.Sprintf("in %d days", days)
+	}
+
+	return fmt.Sprintf("%d days ago", -days)
+}
+
+func today() time.Time {
+	year, month, day := time.Now().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// addMonths moves to the same day in another month, or to the last day of that
+// month if it is shorter.
+func addMonths(date time.Time, months int) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(date.Day(), lastDay)-1)
+}
@@ -0,0 +1,115 @@
+package forms
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+const autosaveInterval = 5 * time.Second
+
+// autosaveTickMsg drives the autosave loop. Each load of the form starts a new
+// loop generation so ticks from earlier loads are dropped.
+type autosaveTickMsg struct {
+	formID     string
+	generation int
+}
+
+func (m Model) autosaveTick() tea.Cmd {
+	if m.drafts == nil || m.draftKey == "" {
+		return nil
+	}
+
+	msg := autosaveTickMsg{
+		formID:     m.id,
+		generation: m.autosaveGeneration,
+	}
+
+	return tea.Tick(autosaveInterval, func(time.Time) tea.Msg {
+		return msg
+	})
+}
+
+// autosave persists the current values while the form is dirty and drops the
+// draft once the form is back to its initial values.
+func (m Model) autosave() {
+	if m.drafts == nil || m.draftKey == "" {
+		return
+	}
+
+	var err error
+	if m.IsDirty() {
+		err = m.drafts.SaveDraft(m.id, m.draftKey, m.Value())
+	} else {
+		err = m.drafts.DeleteDraft(m.id, m.draftKey)
+	}
+
+	if err != nil {
+		m.logger.Error("failed to autosave form draft", "form", m.id, "error", err)
+	}
+}
+
+func (m *Model) checkForDraft() {
+	if m.drafts == nil || m.draftKey == "" {
+		return
+	}
+
+	draft, found, err := m.drafts.LoadDraft(m.id, m.draftKey)
+	if err != nil {
+		m.logger.Error("failed to load form draft", "form", m.id, "error", err)
+		return
+	}
+
+	if !found {
+		return
+	}
+
+	m.pendingDraft = draft
+	m.ask(restoreDraftPrompt, fmt.Sprintf(
+		"Restore unsaved draft from %s?",
+		draft.SavedAt.Format("Jan 2 15:04"),
+	))
+}
+
+func (m *Model) restoreDraft() {
+	for _, field := range m.fields {
+		if value, ok := m.pendingDraft.Values[field.GetID()]; ok {
+			field.SetValue(value)
+		}
+	}
+
+	m.pendingDraft = store.Draft{}
+}
+
+func (m *Model) discardDraft() {
+	if m.drafts != nil && m.draftKey != "" {
+		if err := m.drafts.DeleteDraft(m.id, m.draftKey); err != nil {
+			m.logger.Error("failed to delete form draft", "form", m.id, "error", err)
+		}
+	}
+
+	m.pendingDraft = store.Draft{}
+}
+
+// ClearDraft removes any saved draft and marks the current values as clean.
+// Owning views call it once the form has been submitted successfully.
+func (m *Model) ClearDraft() {
+	m.discardDraft()
+	m.draftKey = ""
+
+	m.initial = make(map[string]any, len(m.fields))
+	for _, field := range m.fields {
+		m.initial[field.GetID()] = field.GetValue()
+	}
+}
+
+// WithDrafts enables periodic autosaving of unsubmitted values to the store.
+func WithDrafts(drafts *store.Store, logger *logger.Logger) FormModelOption {
+	return func(m *Model) {
+		m.drafts = drafts
+		m.logger = logger
+	}
+}
@@ -1,6 +1,9 @@
 package forms
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
 
 type FormField interface {
 	Init() tea.Cmd
@@ -16,9 +19,33 @@ type FormField interface {
 
 	GetID() string
 
-	GetValue() string
-	SetValue(string)
+	GetValue() any
+	SetValue(any)
 
 	SetSize(width int, height int)
 	SetPanelSize(width int, height int)
 }
+
+// textEntryField is implemented by fields that consume printable keys, so the
+// form does not treat those keys as shortcuts while the field is focused.
+type textEntryField interface {
+	AcceptsText() bool
+}
+
+// keyCapturingField is implemented by fields that need keys the form would
+// otherwise handle itself, like enter in a multi-line input.
+type keyCapturingField interface {
+	CapturesKey(msg tea.KeyMsg) bool
+}
+
+// helpKeysField is implemented by fields with key bindings of their own, which
+// are listed in the form help while the field is focused.
+type helpKeysField interface {
+	HelpKeys() []key.Binding
+}
+
+// clickableField is implemented by fields that respond to clicks on their own
+// content, not just by taking focus.
+type clickableField interface {
+	Click(msg tea.MouseMsg)
+}
@@ -12,21 +12,44 @@ type FormkeyAction struct {
 }
 
 type formKeyMap struct {
-	Next   key.Binding
-	Submit key.Binding
+	Next     key.Binding
+	Prev     key.Binding
+	Advance  key.Binding
+	JumpTo   key.Binding
+	Submit   key.Binding
+	Cancel   key.Binding
+	ShowHelp key.Binding
 }
 
-// TODO add toggle full help menu
 func newFormKeyMap() formKeyMap {
 	return formKeyMap{
 		Next: key.NewBinding(
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "next field"),
 		),
-		Submit: key.NewBinding(
-			// TODO use a different key combo
+		Prev: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous field"),
+		),
+		Advance: key.NewBinding(
 			key.WithKeys("enter"),
-			key.WithHelp("enter", "submit"),
+			key.WithHelp("enter", "next / choose"),
+		),
+		JumpTo: key.NewBinding(
+			key.WithKeys("alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"),
+			key.WithHelp("alt+1-9", "jump to field"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "submit"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		ShowHelp: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
 		),
 	}
 }
@@ -35,11 +58,33 @@ func (k formKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.Next,
 		k.Submit,
+		k.Cancel,
+		k.ShowHelp,
 	}
 }
 
 func (k formKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Next, k.Submit},
+		{k.Next, k.Prev, k.Advance, k.JumpTo},
+		{k.Submit, k.Cancel, k.ShowHelp},
+	}
+}
+
+// fieldKeyMap adds the bindings of the focused field to the form bindings.
+type fieldKeyMap struct {
+	formKeyMap
+
+	fieldKeys []key.Binding
+}
+
+func (k fieldKeyMap) ShortHelp() []key.Binding {
+	return append(k.formKeyMap.ShortHelp(), k.fieldKeys...)
+}
+
+func (k fieldKeyMap) FullHelp() [][]key.Binding {
+	if len(k.fieldKeys) == 0 {
+		return k.formKeyMap.FullHelp()
 	}
+
+	return append(k.formKeyMap.FullHelp(), k.fieldKeys)
 }
@@ -0,0 +1,273 @@
+package forms
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	teatextarea "github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/markdown"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+const markdownInputHeight = 6
+
+type markdownKeyMap struct {
+	TogglePreview key.Binding
+	NextItem      key.Binding
+	PrevItem      key.Binding
+	ToggleItem    key.Binding
+	ScrollDown    key.Binding
+	ScrollUp      key.Binding
+}
+
+func newMarkdownKeyMap() markdownKeyMap {
+	return markdownKeyMap{
+		TogglePreview: key.NewBinding(
+			key.WithKeys("ctrl+p"),
+			key.WithHelp("ctrl+p", "toggle preview"),
+		),
+		NextItem: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next checklist item"),
+		),
+		PrevItem: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous checklist item"),
+		),
+		ToggleItem: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "check / uncheck"),
+		),
+		ScrollDown: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "scroll down"),
+		),
+		ScrollUp: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "scroll up"),
+		),
+	}
+}
+
+// MarkdownInput is a multi-line input for Markdown with a read-only preview.
+// Checklist items can be ticked from the preview.
+type MarkdownInput struct {
+	id    string
+	label string
+
+	textarea  teatextarea.Model
+	preview   viewport.Model
+	checklist markdown.ChecklistCursor
+
+	previewing bool
+	keys       markdownKeyMap
+
+	width int
+}
+
+func NewMarkdownInput(id string, label string) FormField {
+	textarea := teatextarea.New()
+	textarea.Prompt = ""
+	textarea.ShowLineNumbers = false
+	textarea.CharLimit = 0
+	textarea.SetHeight(markdownInputHeight)
+	textarea.FocusedStyle.CursorLine = lipgloss.NewStyle()
+
+	preview := viewport.New(0, markdownInputHeight)
+	preview.MouseWheelEnabled = true
+
+	return &MarkdownInput{
+		id:       id,
+		label:    label,
+		textarea: textarea,
+		preview:  preview,
+		keys:     newMarkdownKeyMap(),
+	}
+}
+
+func (t *MarkdownInput) Init() tea.Cmd {
+	return nil
+}
+
+func (t *MarkdownInput) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return t.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		if t.previewing {
+			t.preview, cmd = t.preview.Update(msg)
+		}
+
+		return t, cmd
+	}
+
+	t.textarea, cmd = t.textarea.Update(msg)
+
+	return t, cmd
+}
+
+func (t *MarkdownInput) View() string {
+	label := t.label
+	if t.previewing {
+		label += " (preview)"
+	}
+
+	renderedLabel := ""
+	if label != "" {
+		renderedLabel = styles.InputLabelStyle.Render(label)
+	}
+
+	content := t.textarea.View()
+	if t.previewing {
+		content = t.preview.View()
+	}
+
+	frameWidth, _ := styles.InputStyle.GetFrameSize()
+	renderedInput := styles.InputStyle.
+		Width(t.width - frameWidth).
+		Render(content)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		renderedLabel,
+		renderedInput,
+	)
+}
+
+func (t *MarkdownInput) ViewSidePanel() string {
+	return ""
+}
+
+func (t *MarkdownInput) Blur() tea.Cmd {
+	t.textarea.Blur()
+
+	return nil
+}
+
+func (t *MarkdownInput) Focus() tea.Cmd {
+	if t.previewing {
+		return nil
+	}
+
+	return t.textarea.Focus()
+}
+
+func (t *MarkdownInput) HasPanelContent() bool {
+	return false
+}
+
+func (t *MarkdownInput) AcceptsText() bool {
+	return !t.previewing
+}
+
+// CapturesKey claims enter for new lines while editing, and the checklist and
+// scroll keys while previewing.
+func (t *MarkdownInput) CapturesKey(msg tea.KeyMsg) bool {
+	if !t.previewing {
+		return msg.Type == tea.KeyEnter
+	}
+
+	return key.Matches(msg, t.keys.NextItem, t.keys.PrevItem, t.keys.ToggleItem, t.keys.ScrollDown, t.keys.ScrollUp)
+}
+
+func (t *MarkdownInput) HelpKeys() []key.Binding {
+	if !t.previewing {
+		return []key.Binding{t.keys.TogglePreview}
+	}
+
+	return []key.Binding{t.keys.TogglePreview, t.keys.NextItem, t.keys.ToggleItem}
+}
+
+func (t *MarkdownInput) GetID() string {
+	return t.id
+}
+
+func (t *MarkdownInput) GetValue() any {
+	return t.textarea.Value()
+}
+
+func (t *MarkdownInput) SetValue(value any) {
+	t.textarea.SetValue(formatValue(value))
+	t.previewing = false
+	t.checklist.Reset()
+}
+
+func (t *MarkdownInput) SetSize(width int, height int) {
+	t.width = width
+
+	inputFrameWidth, _ := styles.InputStyle.GetFrameSize()
+
+	t.textarea.SetWidth(width - inputFrameWidth)
+	t.preview.Width = width - inputFrameWidth
+	t.renderPreview()
+}
+
+func (t *MarkdownInput) SetPanelSize(width int, height int) {}
+
+func (t *MarkdownInput) onKeyMsg(msg tea.KeyMsg) (*MarkdownInput, tea.Cmd) {
+	var cmd tea.Cmd
+
+	if key.Matches(msg, t.keys.TogglePreview) {
+		return t, t.togglePreview()
+	}
+
+	if !t.previewing {
+		t.textarea, cmd = t.textarea.Update(msg)
+		return t, cmd
+	}
+
+	value := t.textarea.Value()
+
+	switch {
+	case key.Matches(msg, t.keys.NextItem):
+		t.checklist.Next(value)
+
+	case key.Matches(msg, t.keys.PrevItem):
+		t.checklist.Prev(value)
+
+	case key.Matches(msg, t.keys.ToggleItem):
+		toggled, err := markdown.ToggleChecklistItem(value, t.checklist.Index())
+		if err != nil {
+			return t, nil
+		}
+
+		t.textarea.SetValue(toggled)
+
+	default:
+		t.preview, cmd = t.preview.Update(msg)
+		return t, cmd
+	}
+
+	t.renderPreview()
+
+	return t, nil
+}
+
+func (t *MarkdownInput) togglePreview() tea.Cmd {
+	t.previewing = !t.previewing
+
+	if !t.previewing {
+		return t.textarea.Focus()
+	}
+
+	t.textarea.Blur()
+	t.checklist.Reset()
+	t.preview.GotoTop()
+	t.renderPreview()
+
+	return nil
+}
+
+func (t *MarkdownInput) renderPreview() {
+	if !t.previewing {
+		return
+	}
+
+	source := markdown.MarkChecklistItem(t.textarea.Value(), t.checklist.Index())
+
+	t.preview.SetContent(markdown.Render(source, t.preview.Width))
+}
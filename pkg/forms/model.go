@@ -2,27 +2,69 @@ package forms
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/confirm"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
 	"github.com/mole-squad/soq-tui/pkg/sidepanelview"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
 	"github.com/mole-squad/soq-tui/pkg/utils"
 )
 
+const dirtyMarker = "▎"
+
+// panelWidth fits the date picker's calendar.
+const panelWidth = 24
+
+type formPrompt int
+
+const (
+	noPrompt formPrompt = iota
+	discardChangesPrompt
+	restoreDraftPrompt
+)
+
+type fieldMeta struct {
+	defaultValue any
+	help         string
+	validators   []Validator
+	visibleIf    VisibilityRule
+}
+
 type Model struct {
 	id string
 
-	fields []FormField
+	fields  []FormField
+	meta    map[string]fieldMeta
+	errors  FieldErrors
+	initial map[string]any
 
 	focusedIdx int
 
+	drafts             *store.Store
+	logger             *logger.Logger
+	draftKey           string
+	pendingDraft       store.Draft
+	autosaveGeneration int
+
+	prompt  formPrompt
+	confirm confirm.Model
+
 	panelView sidepanelview.Model
 
-	keys formKeyMap
-	help help.Model
+	keys       formKeyMap
+	help       help.Model
+	helpBar    mouse.HelpBar
+	zonePrefix string
 
 	height int
 	width  int
@@ -34,10 +76,16 @@ func New(formID string, opts ...FormModelOption) Model {
 	model := Model{
 		id:         formID,
 		fields:     make([]FormField, 0),
+		meta:       make(map[string]fieldMeta),
+		errors:     make(FieldErrors),
+		initial:    make(map[string]any),
 		focusedIdx: 0,
 		keys:       newFormKeyMap(),
+		confirm:    confirm.New(),
 		help:       help.New(),
-		panelView:  sidepanelview.New(),
+		helpBar:    mouse.NewHelpBar(),
+		zonePrefix: zone.NewPrefix(),
+		panelView:  sidepanelview.New(sidepanelview.WithPanelWidth(panelWidth)),
 	}
 
 	for _, opt := range opts {
@@ -65,15 +113,40 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.onKeyMsg(msg)
 
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+
 	case SetFieldValueMsg:
 		if m.id == msg.FormID {
 			for i, field := range m.fields {
 				if field.GetID() == msg.FieldID {
 					m.fields[i].SetValue(msg.Value)
+					m.initial[msg.FieldID] = m.fields[i].GetValue()
+					m.clearError(msg.FieldID)
 					break
 				}
 			}
 		}
+
+	case SetValuesMsg:
+		if m.id == msg.FormID {
+			m.setValues(msg.Values)
+			m.draftKey = msg.DraftKey
+			m.checkForDraft()
+
+			m.autosaveGeneration++
+			return m, m.autosaveTick()
+		}
+
+	case autosaveTickMsg:
+		if m.id == msg.formID {
+			if msg.generation != m.autosaveGeneration {
+				return m, nil
+			}
+
+			m.autosave()
+			return m, m.autosaveTick()
+		}
 	}
 
 	var cmds []tea.Cmd
@@ -85,13 +158,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) View() string {
-	help := m.help.View(m.keys)
+	help := m.renderHelp()
 	availHeight := m.height - lipgloss.Height(help)
 
-	renderedFields := make([]string, len(m.fields))
+	renderedFields := make([]string, 0, len(m.fields))
 
-	for i, field := range m.fields {
-		renderedFields[i] = field.View()
+	for _, field := range m.fields {
+		if !m.isVisible(field) {
+			continue
+		}
+
+		renderedFields = append(renderedFields, m.renderField(field))
 	}
 
 	sidePanelContent := ""
@@ -116,8 +193,15 @@ func (m *Model) Focus() tea.Cmd {
 		return nil
 	}
 
+	if !m.isVisible(m.fields[m.focusedIdx]) {
+		m.focusedIdx = m.nextVisibleIdx(m.focusedIdx)
+	}
+
 	field := m.fields[m.focusedIdx]
 
+	m.panelView.SetIsOpen(field.HasPanelContent())
+	m.resizeFields()
+
 	return field.Focus()
 }
 
@@ -131,49 +215,124 @@ func (m Model) Blur() tea.Cmd {
 	return field.Blur()
 }
 
-func (m Model) Value() map[string]string {
-	values := make(map[string]string)
+// Value returns the values of every visible field keyed by field ID.
+func (m Model) Value() map[string]any {
+	values := make(map[string]any)
 
 	for _, field := range m.fields {
+		if !m.isVisible(field) {
+			continue
+		}
+
 		values[field.GetID()] = field.GetValue()
 	}
 
 	return values
 }
 
+func (m Model) Bind(dest any) error {
+	return Bind(m.Value(), dest)
+}
+
+// IsDirty reports whether any visible field differs from the value it was
+// loaded with.
+func (m Model) IsDirty() bool {
+	for _, field := range m.fields {
+		if m.isVisible(field) && m.isFieldDirty(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate runs the validators of every visible field and returns the failures
+// keyed by field ID.
+func (m Model) Validate() FieldErrors {
+	errs := make(FieldErrors)
+
+	for _, field := range m.fields {
+		if !m.isVisible(field) {
+			continue
+		}
+
+		for _, validator := range m.meta[field.GetID()].validators {
+			if err := validator(field.GetValue()); err != nil {
+				errs[field.GetID()] = err
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
 func (m Model) onWindowMsg(msg tea.WindowSizeMsg) (Model, tea.Cmd) {
 	m.height = msg.Height
 	m.width = msg.Width
+	m.help.Width = msg.Width
 
-	help := m.help.View(m.keys)
-	availHeight := m.height - lipgloss.Height(help)
+	cmd := m.layout()
+
+	return m, cmd
+}
+
+// layout sizes the panel and fields to the space left above the help, which
+// grows while a prompt is open.
+func (m *Model) layout() tea.Cmd {
+	availHeight := m.height - lipgloss.Height(m.renderHelp())
 
 	var cmd tea.Cmd
 	m.panelView, cmd = m.panelView.Update(
 		tea.WindowSizeMsg{Width: m.width, Height: availHeight},
 	)
 
-	panelContentWidth, panelContentHeight := m.panelView.GetPanelContentSize()
-
-	for _, field := range m.fields {
-		field.SetSize(m.panelView.GetContentWidth(), availHeight)
-		field.SetPanelSize(panelContentWidth, panelContentHeight)
-	}
+	m.resizeFields()
 
-	return m, cmd
+	return cmd
 }
 
 func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
-	var cmd tea.Cmd
+	if m.prompt != noPrompt {
+		return m.onPromptMsg(msg)
+	}
+
+	if m.focusedFieldCapturesKey(msg) {
+		return m.updateFocusedField(msg)
+	}
 
 	switch {
 	case key.Matches(msg, m.keys.Next):
-		return m.next()
+		return m.focusField(m.nextVisibleIdx(m.focusedIdx))
+
+	case key.Matches(msg, m.keys.Prev):
+		return m.focusField(m.prevVisibleIdx(m.focusedIdx))
+
+	case key.Matches(msg, m.keys.Advance):
+		return m.advance()
+
+	case key.Matches(msg, m.keys.JumpTo):
+		return m.jumpTo(msg)
 
 	case key.Matches(msg, m.keys.Submit):
-		return m, NewSubmitFormCmd(m.id)
+		return m.submit()
+
+	case key.Matches(msg, m.keys.Cancel):
+		return m.cancel()
+
+	case key.Matches(msg, m.keys.ShowHelp) && !m.focusedFieldAcceptsText():
+		m.help.ShowAll = !m.help.ShowAll
+		m.resizeFields()
+
+		return m, nil
 	}
 
+	return m.updateFocusedField(msg)
+}
+
+func (m Model) updateFocusedField(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+
 	if len(m.fields) == 0 {
 		return m, nil
 	}
@@ -185,35 +344,308 @@ func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 	field := m.fields[m.focusedIdx]
 
 	m.fields[m.focusedIdx], cmd = utils.ApplyUpdate(field, msg)
+	m.clearError(field.GetID())
+
+	return m, cmd
+}
+
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if m.prompt != noPrompt {
+		return m.onPromptMsg(msg)
+	}
+
+	if keyMsg, ok := m.helpBar.Clicked(m.helpKeys(), msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	if len(m.fields) == 0 {
+		return m, nil
+	}
+
+	if mouse.IsLeftClick(msg) {
+		for idx, field := range m.fields {
+			if m.isVisible(field) && zone.Get(m.fieldZoneID(field)).InBounds(msg) {
+				if clickable, ok := field.(clickableField); ok {
+					clickable.Click(msg)
+				}
+
+				return m.focusField(idx)
+			}
+		}
+	}
+
+	if !m.panelView.InPanel(msg) {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.fields[m.focusedIdx], cmd = utils.ApplyUpdate(m.fields[m.focusedIdx], msg)
 
 	return m, cmd
 }
 
-func (m Model) next() (Model, tea.Cmd) {
+func (m Model) onPromptMsg(msg tea.Msg) (Model, tea.Cmd) {
+	prompt := m.prompt
+
+	var answer confirm.Answer
+	m.confirm, answer = m.confirm.Update(msg)
+
+	switch answer {
+	case confirm.Confirmed:
+		m.prompt = noPrompt
+		m.layout()
+
+		switch prompt {
+		case discardChangesPrompt:
+			m.discardDraft()
+			m.draftKey = ""
+
+			return m, NewCancelFormCmd(m.id)
+
+		case restoreDraftPrompt:
+			m.restoreDraft()
+		}
+
+	case confirm.Denied:
+		m.prompt = noPrompt
+		m.layout()
+
+		if prompt == restoreDraftPrompt {
+			m.discardDraft()
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) cancel() (Model, tea.Cmd) {
+	if m.IsDirty() {
+		m.ask(discardChangesPrompt, "Discard unsaved changes?")
+		return m, nil
+	}
+
+	m.discardDraft()
+	m.draftKey = ""
+
+	return m, NewCancelFormCmd(m.id)
+}
+
+func (m Model) submit() (Model, tea.Cmd) {
+	m.errors = m.Validate()
+	if len(m.errors) > 0 {
+		return m, nil
+	}
+
+	return m, NewSubmitFormCmd(m.id)
+}
+
+// advance moves to the next field, submitting the form from the last one.
+func (m Model) advance() (Model, tea.Cmd) {
+	if m.nextVisibleIdx(m.focusedIdx) <= m.focusedIdx {
+		return m.submit()
+	}
+
+	return m.focusField(m.nextVisibleIdx(m.focusedIdx))
+}
+
+func (m Model) jumpTo(msg tea.KeyMsg) (Model, tea.Cmd) {
+	position, err := strconv.Atoi(strings.TrimPrefix(msg.String(), "alt+"))
+	if err != nil {
+		return m, nil
+	}
+
+	for idx, field := range m.fields {
+		if !m.isVisible(field) {
+			continue
+		}
+
+		position--
+		if position == 0 {
+			return m.focusField(idx)
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) focusField(idx int) (Model, tea.Cmd) {
 	if len(m.fields) == 0 {
 		return m, nil
 	}
 
 	m.fields[m.focusedIdx].Blur()
 
-	m.focusedIdx = (m.focusedIdx + 1) % len(m.fields)
-	m.fields[m.focusedIdx].Focus()
+	m.focusedIdx = idx
+	cmd := m.fields[m.focusedIdx].Focus()
 
 	m.panelView.SetIsOpen(
 		m.fields[m.focusedIdx].HasPanelContent(),
 	)
 
-	help := m.help.View(m.keys)
-	availHeight := m.height - lipgloss.Height(help)
+	m.resizeFields()
+
+	return m, cmd
+}
+
+func (m Model) focusedFieldCapturesKey(msg tea.KeyMsg) bool {
+	if len(m.fields) == 0 {
+		return false
+	}
+
+	field, ok := m.fields[m.focusedIdx].(keyCapturingField)
+
+	return ok && field.CapturesKey(msg)
+}
+
+func (m Model) focusedFieldAcceptsText() bool {
+	if len(m.fields) == 0 {
+		return false
+	}
+
+	field, ok := m.fields[m.focusedIdx].(textEntryField)
+
+	return ok && field.AcceptsText()
+}
 
+func (m Model) resizeFields() {
+	availHeight := m.height - lipgloss.Height(m.renderHelp())
+
+	fieldWidth := m.panelView.GetContentWidth() - lipgloss.Width(dirtyMarker)
 	panelContentWidth, panelContentHeight := m.panelView.GetPanelContentSize()
 
 	for _, field := range m.fields {
-		field.SetSize(m.panelView.GetContentWidth(), availHeight)
+		field.SetSize(fieldWidth, availHeight)
 		field.SetPanelSize(panelContentWidth, panelContentHeight)
 	}
+}
 
-	return m, nil
+func (m Model) nextVisibleIdx(from int) int {
+	for offset := 1; offset <= len(m.fields); offset++ {
+		idx := (from + offset) % len(m.fields)
+		if m.isVisible(m.fields[idx]) {
+			return idx
+		}
+	}
+
+	return from
+}
+
+func (m Model) prevVisibleIdx(from int) int {
+	for offset := 1; offset <= len(m.fields); offset++ {
+		idx := (from - offset + len(m.fields)) % len(m.fields)
+		if m.isVisible(m.fields[idx]) {
+			return idx
+		}
+	}
+
+	return from
+}
+
+func (m *Model) setValues(values map[string]any) {
+	m.initial = make(map[string]any, len(m.fields))
+
+	for _, field := range m.fields {
+		value, ok := values[field.GetID()]
+		if !ok {
+			value = m.meta[field.GetID()].defaultValue
+		}
+
+		field.SetValue(value)
+		m.initial[field.GetID()] = field.GetValue()
+	}
+
+	m.errors = make(FieldErrors)
+	m.prompt = noPrompt
+	m.confirm = m.confirm.Close()
+}
+
+func (m *Model) ask(prompt formPrompt, question string) {
+	m.prompt = prompt
+	m.confirm = m.confirm.Ask(question)
+	m.layout()
+}
+
+func (m Model) renderField(field FormField) string {
+	rendered := field.View()
+
+	meta := m.meta[field.GetID()]
+
+	if err, ok := m.errors[field.GetID()]; ok {
+		rendered = lipgloss.JoinVertical(lipgloss.Left, rendered, styles.InputErrorStyle.Render(err.Error()))
+	} else if meta.help != "" {
+		rendered = lipgloss.JoinVertical(lipgloss.Left, rendered, styles.InputHelpStyle.Render(meta.help))
+	}
+
+	marker := strings.Repeat(" ", lipgloss.Width(dirtyMarker))
+	if m.isFieldDirty(field) {
+		marker = dirtyMarker
+	}
+
+	gutter := strings.TrimSuffix(strings.Repeat(marker+"\n", lipgloss.Height(rendered)), "\n")
+
+	return zone.Mark(
+		m.fieldZoneID(field),
+		lipgloss.JoinHorizontal(lipgloss.Top, styles.DirtyMarkerStyle.Render(gutter), rendered),
+	)
+}
+
+func (m Model) fieldZoneID(field FormField) string {
+	return m.zonePrefix + "field-" + field.GetID()
+}
+
+func (m Model) renderHelp() string {
+	if m.prompt != noPrompt {
+		return m.confirm.View()
+	}
+
+	return m.helpBar.View(m.help, m.helpKeys())
+}
+
+func (m Model) helpKeys() fieldKeyMap {
+	keys := fieldKeyMap{formKeyMap: m.keys}
+
+	if len(m.fields) == 0 {
+		return keys
+	}
+
+	if field, ok := m.fields[m.focusedIdx].(helpKeysField); ok {
+		keys.fieldKeys = field.HelpKeys()
+	}
+
+	return keys
+}
+
+func (m Model) isFieldDirty(field FormField) bool {
+	return !valuesEqual(m.initial[field.GetID()], field.GetValue())
+}
+
+func (m Model) isVisible(field FormField) bool {
+	rule := m.meta[field.GetID()].visibleIf
+	if rule == nil {
+		return true
+	}
+
+	values := make(map[string]any, len(m.fields))
+	for _, f := range m.fields {
+		values[f.GetID()] = f.GetValue()
+	}
+
+	return rule(values)
+}
+
+func (m *Model) clearError(fieldID string) {
+	if _, ok := m.errors[fieldID]; !ok {
+		return
+	}
+
+	errs := make(FieldErrors, len(m.errors))
+	for id, err := range m.errors {
+		if id != fieldID {
+			errs[id] = err
+		}
+	}
+
+	m.errors = errs
 }
 
 func WithField(field FormField) FormModelOption {
@@ -221,3 +653,17 @@ func WithField(field FormField) FormModelOption {
 		m.fields = append(m.fields, field)
 	}
 }
+
+func withFieldSpec(field FormField, spec FieldSpec) FormModelOption {
+	return func(m *Model) {
+		m.fields = append(m.fields, field)
+		m.meta[spec.ID] = fieldMeta{
+			defaultValue: spec.Default,
+			help:         spec.Help,
+			validators:   spec.Validators,
+			visibleIf:    spec.VisibleIf,
+		}
+
+		field.SetValue(spec.Default)
+	}
+}
@@ -0,0 +1,194 @@
+package forms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+var (
+	swatchStyle = lipgloss.NewStyle().Padding(0, 1)
+
+	selectedSwatchStyle = lipgloss.NewStyle().
+				Padding(0, 1).
+				Reverse(true)
+)
+
+type paletteKeyMap struct {
+	Next key.Binding
+	Prev key.Binding
+}
+
+func newPaletteKeyMap() paletteKeyMap {
+	return paletteKeyMap{
+		Next: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "next color"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "previous color"),
+		),
+	}
+}
+
+// PaletteInput picks a color from styles.Palette. Its value is the color as a
+// hex string, or empty for no color.
+type PaletteInput struct {
+	id    string
+	label string
+
+	// selected indexes styles.Palette, offset by one for "none".
+	selected int
+	keys     paletteKeyMap
+
+	zonePrefix string
+	width      int
+}
+
+func NewPaletteInput(id string, label string) FormField {
+	return &PaletteInput{
+		id:         id,
+		label:      label,
+		keys:       newPaletteKeyMap(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (p *PaletteInput) Init() tea.Cmd {
+	return nil
+}
+
+func (p *PaletteInput) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	count := len(styles.Palette) + 1
+
+	switch {
+	case key.Matches(keyMsg, p.keys.Next):
+		p.selected = (p.selected + 1) % count
+
+	case key.Matches(keyMsg, p.keys.Prev):
+		p.selected = (p.selected - 1 + count) % count
+	}
+
+	return p, nil
+}
+
+// Click selects the swatch under the pointer.
+func (p *PaletteInput) Click(msg tea.MouseMsg) {
+	for idx := 0; idx <= len(styles.Palette); idx++ {
+		if zone.Get(p.swatchZoneID(idx)).InBounds(msg) {
+			p.selected = idx
+			return
+		}
+	}
+}
+
+func (p *PaletteInput) View() string {
+	renderedLabel := ""
+	if p.label != "" {
+		renderedLabel = styles.InputLabelStyle.Render(p.label)
+	}
+
+	swatches := make([]string, 0, len(styles.Palette)+2)
+
+	for idx := 0; idx <= len(styles.Palette); idx++ {
+		style := swatchStyle
+		if idx == p.selected {
+			style = selectedSwatchStyle
+		}
+
+		swatch := "○"
+		if idx > 0 {
+			swatch = "●"
+			style = style.Foreground(styles.Palette[idx-1].Color)
+		}
+
+		swatches = append(swatches, zone.Mark(p.swatchZoneID(idx), style.Render(swatch)))
+	}
+
+	swatches = append(swatches, styles.InputHelpStyle.Render(p.selectedName()))
+
+	frameWidth, _ := styles.InputStyle.GetFrameSize()
+	renderedInput := styles.InputStyle.
+		Width(p.width - frameWidth).
+		Render(lipgloss.JoinHorizontal(lipgloss.Top, swatches...))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		renderedLabel,
+		renderedInput,
+	)
+}
+
+func (p *PaletteInput) ViewSidePanel() string {
+	return ""
+}
+
+func (p *PaletteInput) Blur() tea.Cmd {
+	return nil
+}
+
+func (p *PaletteInput) Focus() tea.Cmd {
+	return nil
+}
+
+func (p *PaletteInput) HasPanelContent() bool {
+	return false
+}
+
+func (p *PaletteInput) HelpKeys() []key.Binding {
+	return []key.Binding{p.keys.Prev, p.keys.Next}
+}
+
+func (p *PaletteInput) GetID() string {
+	return p.id
+}
+
+func (p *PaletteInput) GetValue() any {
+	if p.selected == 0 {
+		return ""
+	}
+
+	return string(styles.Palette[p.selected-1].Color)
+}
+
+// SetValue selects the palette color matching value. Unknown colors select
+// none.
+func (p *PaletteInput) SetValue(value any) {
+	p.selected = 0
+
+	color := formatValue(value)
+	for idx, entry := range styles.Palette {
+		if strings.EqualFold(string(entry.Color), color) {
+			p.selected = idx + 1
+		}
+	}
+}
+
+func (p *PaletteInput) selectedName() string {
+	if p.selected == 0 {
+		return "None"
+	}
+
+	return styles.Palette[p.selected-1].Name
+}
+
+func (p *PaletteInput) SetSize(width int, height int) {
+	p.width = width
+}
+
+func (p *PaletteInput) SetPanelSize(width int, height int) {}
+
+func (p *PaletteInput) swatchZoneID(idx int) string {
+	return fmt.Sprintf("%sswatch-%d", p.zonePrefix, idx)
+}
@@ -0,0 +1,185 @@
+package forms
+
+import "fmt"
+
+type FieldType string
+
+const (
+	FieldTypeText      FieldType = "text"
+	FieldTypePassword  FieldType = "password"
+	FieldTypeSelect    FieldType = "select"
+	FieldTypeMarkdown  FieldType = "markdown"
+	FieldTypePalette   FieldType = "palette"
+	FieldTypeDate      FieldType = "date"
+	FieldTypeChecklist FieldType = "checklist"
+	FieldTypeTags      FieldType = "tags"
+)
+
+// VisibilityRule decides whether a field is shown given the current form values.
+type VisibilityRule func(values map[string]any) bool
+
+type FieldSpec struct {
+	ID    string
+	Label string
+	Type  FieldType
+
+	Default    any
+	Help       string
+	Validators []Validator
+	VisibleIf  VisibilityRule
+}
+
+type FieldSpecOption func(*FieldSpec)
+
+type Schema struct {
+	ID     string
+	Fields []FieldSpec
+}
+
+func NewSchema(formID string, fields ...FieldSpec) Schema {
+	return Schema{
+		ID:     formID,
+		Fields: fields,
+	}
+}
+
+func NewFieldSpec(fieldType FieldType, id string, label string, opts ...FieldSpecOption) FieldSpec {
+	spec := FieldSpec{
+		ID:    id,
+		Label: label,
+		Type:  fieldType,
+	}
+
+	for _, opt := range opts {
+		opt(&spec)
+	}
+
+	return spec
+}
+
+func TextField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypeText, id, label, opts...)
+}
+
+func PasswordField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypePassword, id, label, opts...)
+}
+
+func SelectField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypeSelect, id, label, opts...)
+}
+
+func MarkdownField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypeMarkdown, id, label, opts...)
+}
+
+func PaletteField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypePalette, id, label, opts...)
+}
+
+func DateField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypeDate, id, label, opts...)
+}
+
+func ChecklistField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypeChecklist, id, label, opts...)
+}
+
+func TagsField(id string, label string, opts ...FieldSpecOption) FieldSpec {
+	return NewFieldSpec(FieldTypeTags, id, label, opts...)
+}
+
+// Build creates a form model with a field for every spec in the schema.
+func (s Schema) Build(opts ...FormModelOption) (Model, error) {
+	fieldOpts := make([]FormModelOption, 0, len(s.Fields)+len(opts))
+
+	for _, spec := range s.Fields {
+		field, err := spec.newField()
+		if err != nil {
+			return Model{}, fmt.Errorf("error building form %s: %w", s.ID, err)
+		}
+
+		fieldOpts = append(fieldOpts, withFieldSpec(field, spec))
+	}
+
+	return New(s.ID, append(fieldOpts, opts...)...), nil
+}
+
+// MustBuild is like Build but panics on an invalid schema. It is intended for
+// schemas declared in code.
+func (s Schema) MustBuild(opts ...FormModelOption) Model {
+	model, err := s.Build(opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return model
+}
+
+func (s FieldSpec) newField() (FormField, error) {
+	switch s.Type {
+	case FieldTypeText, "":
+		return NewTextInput(s.ID, s.Label), nil
+
+	case FieldTypePassword:
+		return NewTextInput(s.ID, s.Label, WithHiddenTextInput()), nil
+
+	case FieldTypeSelect:
+		return NewSelectInput(s.ID, s.Label), nil
+
+	case FieldTypeMarkdown:
+		return NewMarkdownInput(s.ID, s.Label), nil
+
+	case FieldTypePalette:
+		return NewPaletteInput(s.ID, s.Label), nil
+
+	case FieldTypeDate:
+		return NewDateInput(s.ID, s.Label), nil
+
+	case FieldTypeChecklist:
+		return NewChecklistInput(s.ID, s.Label), nil
+
+	case FieldTypeTags:
+		return NewTagInput(s.ID, s.Label), nil
+	}
+
+	return nil, fmt.Errorf("unknown field type %q for field %s", s.Type, s.ID)
+}
+
+func WithDefault(value any) FieldSpecOption {
+	return func(s *FieldSpec) {
+		s.Default = value
+	}
+}
+
+func WithHelp(help string) FieldSpecOption {
+	return func(s *FieldSpec) {
+		s.Help = help
+	}
+}
+
+func WithValidators(validators ...Validator) FieldSpecOption {
+	return func(s *FieldSpec) {
+		s.Validators = append(s.Validators, validators...)
+	}
+}
+
+func WithVisibleIf(rule VisibilityRule) FieldSpecOption {
+	return func(s *FieldSpec) {
+		s.VisibleIf = rule
+	}
+}
+
+// FieldEquals is a VisibilityRule that shows a field only while another field
+// holds the given value.
+func FieldEquals(fieldID string, value any) VisibilityRule {
+	return func(values map[string]any) bool {
+		return valuesEqual(values[fieldID], value)
+	}
+}
+
+func FieldNotEmpty(fieldID string) VisibilityRule {
+	return func(values map[string]any) bool {
+		return formatValue(values[fieldID]) != ""
+	}
+}
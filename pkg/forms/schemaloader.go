@@ -0,0 +1,131 @@
+package forms
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type schemaDef struct {
+	ID     string     `json:"id"`
+	Fields []fieldDef `json:"fields"`
+}
+
+type fieldDef struct {
+	ID       string        `json:"id"`
+	Label    string        `json:"label"`
+	Type     FieldType     `json:"type"`
+	Default  any           `json:"default"`
+	Help     string        `json:"help"`
+	Validate []string      `json:"validate"`
+	ShowWhen *conditionDef `json:"showWhen"`
+}
+
+type conditionDef struct {
+	Field    string `json:"field"`
+	Equals   any    `json:"equals"`
+	NotEmpty bool   `json:"notEmpty"`
+}
+
+// LoadSchema decodes a JSON form definition. Validators are referenced by name,
+// e.g. "required", "minLength:3", "maxLength:120" or "pattern:^[a-z]+$".
+func LoadSchema(r io.Reader) (Schema, error) {
+	var def schemaDef
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&def); err != nil {
+		return Schema{}, fmt.Errorf("error decoding form schema: %w", err)
+	}
+
+	if def.ID == "" {
+		return Schema{}, fmt.Errorf("form schema is missing an id")
+	}
+
+	schema := NewSchema(def.ID)
+
+	for _, fd := range def.Fields {
+		spec, err := fd.toSpec()
+		if err != nil {
+			return Schema{}, fmt.Errorf("error loading field %q: %w", fd.ID, err)
+		}
+
+		schema.Fields = append(schema.Fields, spec)
+	}
+
+	return schema, nil
+}
+
+func (fd fieldDef) toSpec() (FieldSpec, error) {
+	if fd.ID == "" {
+		return FieldSpec{}, fmt.Errorf("field is missing an id")
+	}
+
+	opts := []FieldSpecOption{
+		WithDefault(fd.Default),
+		WithHelp(fd.Help),
+	}
+
+	for _, rawValidator := range fd.Validate {
+		validator, err := parseValidator(rawValidator)
+		if err != nil {
+			return FieldSpec{}, err
+		}
+
+		opts = append(opts, WithValidators(validator))
+	}
+
+	if fd.ShowWhen != nil {
+		if fd.ShowWhen.Field == "" {
+			return FieldSpec{}, fmt.Errorf("showWhen is missing a field")
+		}
+
+		if fd.ShowWhen.NotEmpty {
+			opts = append(opts, WithVisibleIf(FieldNotEmpty(fd.ShowWhen.Field)))
+		} else {
+			opts = append(opts, WithVisibleIf(FieldEquals(fd.ShowWhen.Field, fd.ShowWhen.Equals)))
+		}
+	}
+
+	spec := NewFieldSpec(fd.Type, fd.ID, fd.Label, opts...)
+	if _, err := spec.newField(); err != nil {
+		return FieldSpec{}, err
+	}
+
+	return spec, nil
+}
+
+func parseValidator(raw string) (Validator, error) {
+	name, arg, _ := strings.Cut(raw, ":")
+
+	switch name {
+	case "required":
+		return Required(), nil
+
+	case "minLength", "maxLength":
+		length, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("validator %s expects an integer argument, got %q", name, arg)
+		}
+
+		if name == "minLength" {
+			return MinLength(length), nil
+		}
+
+		return MaxLength(length), nil
+
+	case "pattern":
+		expr, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("validator pattern has an invalid expression: %w", err)
+		}
+
+		return Pattern(expr, fmt.Sprintf("in the format %s", arg)), nil
+	}
+
+	return nil, fmt.Errorf("unknown validator %q", name)
+}
@@ -1,17 +1,26 @@
 package forms
 
 import (
+	"slices"
+
 	tealist "github.com/charmbracelet/bubbles/list"
 	teatextinput "github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
 	"github.com/mole-squad/soq-tui/pkg/styles"
 	"github.com/mole-squad/soq-tui/pkg/utils"
 )
 
 type SelectOption interface {
 	Label() string
-	Value() string
+	Value() any
+}
+
+// styledSelectOption is implemented by options that render differently in the
+// option list than in the input, such as with colors.
+type styledSelectOption interface {
+	StyledLabel() string
 }
 
 type SelectInput struct {
@@ -20,6 +29,7 @@ type SelectInput struct {
 
 	inputModel teatextinput.Model
 	listModel  tealist.Model
+	delegate   mouse.ListDelegate
 
 	width int
 }
@@ -30,7 +40,9 @@ func NewSelectInput(id string, label string, opts ...SelectInputOption) FormFiel
 	inputModel := teatextinput.New()
 	inputModel.Prompt = ""
 
-	listModel := tealist.New([]tealist.Item{}, selectInputDelegate{}, 0, 0)
+	delegate := mouse.NewListDelegate(selectInputDelegate{})
+
+	listModel := tealist.New([]tealist.Item{}, delegate, 0, 0)
 	listModel.Title = label
 
 	listModel.SetShowHelp(false)
@@ -43,6 +55,7 @@ func NewSelectInput(id string, label string, opts ...SelectInputOption) FormFiel
 		label:      label,
 		inputModel: inputModel,
 		listModel:  listModel,
+		delegate:   delegate,
 	}
 
 	for _, opt := range opts {
@@ -68,6 +81,15 @@ func (s *SelectInput) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.InputID == s.id {
 			s.SetOptions(msg.Options)
 		}
+
+	case tea.MouseMsg:
+		s.delegate.HandleMouse(&s.listModel, msg)
+
+		if selected := s.getSelectedItem(); selected != nil {
+			s.inputModel.SetValue(selected.Label())
+		}
+
+		return s, nil
 	}
 
 	selected := s.getSelectedItem()
@@ -120,21 +142,34 @@ func (s SelectInput) GetID() string {
 	return s.id
 }
 
-func (s SelectInput) GetValue() string {
-	return s.getSelectedItem().Value()
+func (s SelectInput) GetValue() any {
+	selected := s.getSelectedItem()
+	if selected == nil {
+		return nil
+	}
+
+	return selected.Value()
 }
 
-func (s *SelectInput) SetValue(selected string) {
-	for i, opt := range s.listModel.Items() {
-		option, ok := opt.(SelectListOption)
-		if !ok {
-			return
-		}
+// SetValue selects the option with the given value. Without a match the first
+// option is selected, so the choice made for an earlier entity does not stay.
+func (s *SelectInput) SetValue(selected any) {
+	items := s.listModel.Items()
+	if len(items) == 0 {
+		s.inputModel.SetValue("")
+		return
+	}
 
-		if option.opt.Value() == selected {
-			s.inputModel.SetValue(option.opt.Label())
-			s.listModel.Select(i)
-		}
+	idx := slices.IndexFunc(items, func(item tealist.Item) bool {
+		option, ok := item.(SelectListOption)
+		return ok && valuesEqual(option.opt.Value(), selected)
+	})
+
+	idx = max(idx, 0)
+	s.listModel.Select(idx)
+
+	if option, ok := items[idx].(SelectListOption); ok {
+		s.inputModel.SetValue(option.opt.Label())
 	}
 }
 
@@ -29,8 +29,8 @@ func (d selectInputDelegate) Render(w io.Writer, m list.Model, index int, listIt
 	}
 
 	if index == m.Index() {
-		fmt.Fprintf(w, "> %s", i.Label())
+		fmt.Fprintf(w, "> %s", i.StyledLabel())
 	} else {
-		fmt.Fprintf(w, "  %s", i.Label())
+		fmt.Fprintf(w, "  %s", i.StyledLabel())
 	}
 }
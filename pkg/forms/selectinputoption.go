@@ -8,6 +8,14 @@ func (s SelectListOption) Label() string {
 	return s.opt.Label()
 }
 
+func (s SelectListOption) StyledLabel() string {
+	if styled, ok := s.opt.(styledSelectOption); ok {
+		return styled.StyledLabel()
+	}
+
+	return s.opt.Label()
+}
+
 func (s SelectListOption) Description() string {
 	return ""
 }
@@ -5,10 +5,10 @@ import tea "github.com/charmbracelet/bubbletea"
 type SetFieldValueMsg struct {
 	FieldID string
 	FormID  string
-	Value   string
+	Value   any
 }
 
-func NewSetFieldValueCmd(formID string, fieldID string, value string) tea.Cmd {
+func NewSetFieldValueCmd(formID string, fieldID string, value any) tea.Cmd {
 	return func() tea.Msg {
 		return SetFieldValueMsg{
 			FormID:  formID,
@@ -0,0 +1,17 @@
+package forms
+
+import tea "github.com/charmbracelet/bubbletea"
+
+type SetSuggestionsMsg struct {
+	InputID     string
+	Suggestions []string
+}
+
+func NewSetSuggestionsCmd(inputID string, suggestions []string) tea.Cmd {
+	return func() tea.Msg {
+		return SetSuggestionsMsg{
+			InputID:     inputID,
+			Suggestions: suggestions,
+		}
+	}
+}
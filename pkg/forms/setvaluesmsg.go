@@ -0,0 +1,22 @@
+package forms
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// SetValuesMsg replaces every value in a form and marks the form as clean.
+// Fields missing from Values are reset to their default. DraftKey identifies the
+// record being edited so unsaved changes can be autosaved and recovered.
+type SetValuesMsg struct {
+	FormID   string
+	DraftKey string
+	Values   map[string]any
+}
+
+func NewSetValuesCmd(formID string, draftKey string, values map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		return SetValuesMsg{
+			FormID:   formID,
+			DraftKey: draftKey,
+			Values:   values,
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package forms
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	teatextinput "github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/tags"
+)
+
+const minTagInputWidth = 12
+
+type tagKeyMap struct {
+	AddTag      key.Binding
+	CompleteTag key.Binding
+	RemoveTag   key.Binding
+}
+
+func newTagKeyMap() tagKeyMap {
+	return tagKeyMap{
+		AddTag: key.NewBinding(
+			key.WithKeys("enter", ","),
+			key.WithHelp("enter", "add tag"),
+		),
+		CompleteTag: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "complete"),
+		),
+		RemoveTag: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "remove last tag"),
+		),
+	}
+}
+
+// TagInput edits a set of tags, shown as chips in front of the text input for
+// the next one. Known tags are suggested while typing. Its value is the tags
+// separated by commas, as read by tags.Parse; text not yet added counts as a
+// tag too.
+type TagInput struct {
+	id    string
+	label string
+
+	tags        []string
+	suggestions []string
+
+	teaInput teatextinput.Model
+	focused  bool
+	keys     tagKeyMap
+
+	zonePrefix string
+	width      int
+}
+
+func NewTagInput(id string, label string) FormField {
+	teaInput := teatextinput.New()
+	teaInput.Prompt = ""
+	teaInput.Placeholder = "Add a tag"
+	teaInput.ShowSuggestions = true
+
+	return &TagInput{
+		id:         id,
+		label:      label,
+		teaInput:   teaInput,
+		keys:       newTagKeyMap(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (t *TagInput) Init() tea.Cmd {
+	return nil
+}
+
+func (t *TagInput) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case SetSuggestionsMsg:
+		if msg.InputID == t.id {
+			t.suggestions = msg.Suggestions
+			t.updateSuggestions()
+		}
+
+		return t, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, t.keys.AddTag):
+			t.addPending()
+			return t, nil
+
+		case key.Matches(msg, t.keys.CompleteTag):
+			if t.hasCompletion() {
+				t.teaInput.SetValue(t.teaInput.CurrentSuggestion())
+				t.addPending()
+			}
+
+			return t, nil
+
+		case key.Matches(msg, t.keys.RemoveTag) && t.teaInput.Value() == "" && len(t.tags) > 0:
+			t.tags = t.tags[:len(t.tags)-1]
+			t.updateSuggestions()
+
+			return t, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	t.teaInput, cmd = t.teaInput.Update(msg)
+
+	return t, cmd
+}
+
+// Click removes the clicked tag.
+func (t *TagInput) Click(msg tea.MouseMsg) {
+	if !mouse.IsLeftClick(msg) {
+		return
+	}
+
+	for idx := range t.tags {
+		if zone.Get(t.chipZoneID(idx)).InBounds(msg) {
+			t.tags = slices.Delete(t.tags, idx, idx+1)
+			t.updateSuggestions()
+
+			return
+		}
+	}
+}
+
+func (t *TagInput) View() string {
+	renderedLabel := ""
+	if t.label != "" {
+		renderedLabel = styles.InputLabelStyle.Render(t.label)
+	}
+
+	chips := make([]string, 0, len(t.tags)+1)
+	for idx, tag := range t.tags {
+		chips = append(chips, zone.Mark(t.chipZoneID(idx), styles.TagChip(tag)))
+	}
+
+	if t.focused || len(t.tags) == 0 {
+		chips = append(chips, t.teaInput.View())
+	}
+
+	frameWidth, _ := styles.InputStyle.GetFrameSize()
+	renderedInput := styles.InputStyle.
+		Width(t.width - frameWidth).
+		Render(strings.Join(chips, " "))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		renderedLabel,
+		renderedInput,
+	)
+}
+
+func (t *TagInput) ViewSidePanel() string {
+	return ""
+}
+
+// Blur adds the text typed so far as a tag, so moving on does not lose it.
+func (t *TagInput) Blur() tea.Cmd {
+	t.focused = false
+	t.addPending()
+	t.teaInput.Blur()
+
+	return nil
+}
+
+func (t *TagInput) Focus() tea.Cmd {
+	t.focused = true
+
+	return t.teaInput.Focus()
+}
+
+func (t *TagInput) HasPanelContent() bool {
+	return false
+}
+
+func (t *TagInput) AcceptsText() bool {
+	return true
+}
+
+// CapturesKey claims enter while there is text to add, and tab while there
+// is a suggestion to complete. Otherwise they move between fields.
+func (t *TagInput) CapturesKey(msg tea.KeyMsg) bool {
+	switch {
+	case key.Matches(msg, t.keys.AddTag):
+		return strings.TrimSpace(t.teaInput.Value()) != ""
+
+	case key.Matches(msg, t.keys.CompleteTag):
+		return t.hasCompletion()
+	}
+
+	return false
+}
+
+func (t *TagInput) HelpKeys() []key.Binding {
+	return []key.Binding{t.keys.AddTag, t.keys.CompleteTag, t.keys.RemoveTag}
+}
+
+func (t *TagInput) GetID() string {
+	return t.id
+}
+
+func (t *TagInput) GetValue() any {
+	value := tags.Format(t.tags)
+	if pending := t.teaInput.Value(); pending != "" {
+		value = tags.Format(tags.Parse(value + "," + pending))
+	}
+
+	return value
+}
+
+func (t *TagInput) SetValue(value any) {
+	t.tags = tags.Parse(formatValue(value))
+	t.teaInput.SetValue("")
+	t.updateSuggestions()
+}
+
+func (t *TagInput) SetSize(width int, height int) {
+	t.width = width
+
+	inputFrameWidth, _ := styles.InputStyle.GetFrameSize()
+
+	// The input shares the line with the chips, each padded and followed by
+	// a space, and its cursor takes a column past its width. It is never
+	// narrower than a short tag.
+	chipsWidth := 1
+	for _, tag := range t.tags {
+		chipsWidth += lipgloss.Width(tag) + 3
+	}
+
+	t.teaInput.Width = max(width-inputFrameWidth-chipsWidth, minTagInputWidth)
+}
+
+func (t *TagInput) SetPanelSize(width int, height int) {}
+
+// addPending turns the text typed so far into tags.
+func (t *TagInput) addPending() {
+	t.tags = tags.Parse(tags.Format(t.tags) + "," + t.teaInput.Value())
+	t.teaInput.SetValue("")
+	t.updateSuggestions()
+}
+
+// updateSuggestions offers the known tags that are not added yet.
+func (t *TagInput) updateSuggestions() {
+	available := make([]string, 0, len(t.suggestions))
+
+	for _, suggestion := range t.suggestions {
+		if !slices.Contains(t.tags, suggestion) {
+			available = append(available, suggestion)
+		}
+	}
+
+	t.teaInput.SetSuggestions(available)
+	t.SetSize(t.width, 0)
+}
+
+// hasCompletion reports whether a suggestion extends the text typed so far.
+// It matches the way the text input picks suggestions.
+func (t *TagInput) hasCompletion() bool {
+	value := strings.ToLower(t.teaInput.Value())
+	if value == "" {
+		return false
+	}
+
+	for _, suggestion := range t.teaInput.AvailableSuggestions() {
+		if strings.HasPrefix(strings.ToLower(suggestion), value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *TagInput) chipZoneID(idx int) string {
+	return fmt.Sprintf("%schip-%d", t.zonePrefix, idx)
+}
@@ -82,16 +82,20 @@ func (t *TextInput) HasPanelContent() bool {
 	return false
 }
 
+func (t *TextInput) AcceptsText() bool {
+	return true
+}
+
 func (t *TextInput) GetID() string {
 	return t.id
 }
 
-func (t *TextInput) GetValue() string {
+func (t *TextInput) GetValue() any {
 	return t.teaInput.Value()
 }
 
-func (t *TextInput) SetValue(value string) {
-	t.teaInput.SetValue(value)
+func (t *TextInput) SetValue(value any) {
+	t.teaInput.SetValue(formatValue(value))
 }
 
 func (t *TextInput) SetSize(width int, height int) {
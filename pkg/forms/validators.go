@@ -0,0 +1,50 @@
+package forms
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+type Validator func(value any) error
+
+func Required() Validator {
+	return func(value any) error {
+		if value == nil || formatValue(value) == "" {
+			return fmt.Errorf("required")
+		}
+
+		return nil
+	}
+}
+
+func MinLength(min int) Validator {
+	return func(value any) error {
+		if utf8.RuneCountInString(formatValue(value)) < min {
+			return fmt.Errorf("must be at least %d characters", min)
+		}
+
+		return nil
+	}
+}
+
+func MaxLength(max int) Validator {
+	return func(value any) error {
+		if utf8.RuneCountInString(formatValue(value)) > max {
+			return fmt.Errorf("must be at most %d characters", max)
+		}
+
+		return nil
+	}
+}
+
+func Pattern(expr *regexp.Regexp, description string) Validator {
+	return func(value any) error {
+		str := formatValue(value)
+		if str != "" && !expr.MatchString(str) {
+			return fmt.Errorf("must be %s", description)
+		}
+
+		return nil
+	}
+}
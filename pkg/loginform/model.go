@@ -2,8 +2,10 @@ package loginform
 
 import (
 	"context"
+	"fmt"
 
 	tea "github.com/charmbracelet/bubbletea"
+	soqapi "github.com/mole-squad/soq-api/api"
 	"github.com/mole-squad/soq-tui/pkg/api"
 	"github.com/mole-squad/soq-tui/pkg/common"
 	"github.com/mole-squad/soq-tui/pkg/forms"
@@ -34,22 +36,11 @@ func New(logger *logger.Logger, client *api.Client) common.AppView {
 		logger: logger,
 	}
 
-	username := forms.NewTextInput(
-		usernameKey,
-		"Username",
-	)
-
-	password := forms.NewTextInput(
-		passwordKey,
-		"Password",
-		forms.WithHiddenTextInput(),
-	)
-
-	model.form = forms.New(
+	model.form = forms.NewSchema(
 		loginFormId,
-		forms.WithField(username),
-		forms.WithField(password),
-	)
+		forms.TextField(usernameKey, "Username", forms.WithValidators(forms.Required())),
+		forms.PasswordField(passwordKey, "Password", forms.WithValidators(forms.Required())),
+	).MustBuild()
 
 	return model
 }
@@ -86,12 +77,12 @@ func (m Model) Focus() (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) onSubmit() tea.Cmd {
-	values := m.form.Value()
-
-	username := values[usernameKey]
-	password := values[passwordKey]
+	var dto soqapi.LoginRequestDTO
+	if err := m.form.Bind(&dto); err != nil {
+		return common.NewErrorMsg(fmt.Errorf("error reading login form: %w", err))
+	}
 
-	token, err := m.client.Login(context.Background(), username, password)
+	token, err := m.client.Login(context.Background(), dto.Username, dto.Password)
 	if err != nil {
 		return common.NewErrorMsg(err)
 	}
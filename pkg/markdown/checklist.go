@@ -0,0 +1,142 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const selectedItemMarker = " ◀"
+
+var checklistItemPattern = regexp.MustCompile(`^(\s*[-*+]\s+\[)([ xX])(\].*)$`)
+
+// ChecklistItem is a `- [ ]` task list item in Markdown source.
+type ChecklistItem struct {
+	Line    int
+	Checked bool
+	Text    string
+}
+
+// ParseChecklist returns the checklist items of source in document order.
+// Items inside fenced code blocks are ignored.
+func ParseChecklist(source string) []ChecklistItem {
+	items := make([]ChecklistItem, 0)
+
+	inFence := false
+
+	for idx, line := range strings.Split(source, "\n") {
+		if isFence(line) {
+			inFence = !inFence
+			continue
+		}
+
+		if inFence {
+			continue
+		}
+
+		match := checklistItemPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		items = append(items, ChecklistItem{
+			Line:    idx,
+			Checked: match[2] != " ",
+			Text:    strings.TrimSpace(strings.TrimPrefix(match[3], "]")),
+		})
+	}
+
+	return items
+}
+
+// ToggleChecklistItem flips the checked state of the checklist item at index
+// and returns the rewritten source.
+func ToggleChecklistItem(source string, index int) (string, error) {
+	items := ParseChecklist(source)
+	if index < 0 || index >= len(items) {
+		return source, fmt.Errorf("checklist item %d out of range", index)
+	}
+
+	lines := strings.Split(source, "\n")
+	item := items[index]
+
+	mark := "x"
+	if item.Checked {
+		mark = " "
+	}
+
+	lines[item.Line] = checklistItemPattern.ReplaceAllString(lines[item.Line], "${1}"+mark+"${3}")
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// MarkChecklistItem appends a marker to the checklist item at index so it can
+// be picked out once the source is rendered. An index out of range leaves the
+// source unchanged.
+func MarkChecklistItem(source string, index int) string {
+	items := ParseChecklist(source)
+	if index < 0 || index >= len(items) {
+		return source
+	}
+
+	lines := strings.Split(source, "\n")
+	lines[items[index].Line] += selectedItemMarker
+
+	return strings.Join(lines, "\n")
+}
+
+func isFence(line string) bool {
+	trimmed := strings.TrimSpace(line)
+
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// ChecklistCursor tracks which checklist item of a document is selected. The
+// zero value has no selection.
+type ChecklistCursor struct {
+	index  int
+	active bool
+}
+
+// Index returns the selected item, or -1 if nothing is selected.
+func (c ChecklistCursor) Index() int {
+	if !c.active {
+		return -1
+	}
+
+	return c.index
+}
+
+func (c *ChecklistCursor) Next(source string) {
+	c.move(source, 1)
+}
+
+func (c *ChecklistCursor) Prev(source string) {
+	c.move(source, -1)
+}
+
+func (c *ChecklistCursor) Reset() {
+	c.index = 0
+	c.active = false
+}
+
+func (c *ChecklistCursor) move(source string, delta int) {
+	count := len(ParseChecklist(source))
+	if count == 0 {
+		c.Reset()
+		return
+	}
+
+	if !c.active {
+		c.active = true
+		c.index = 0
+
+		if delta < 0 {
+			c.index = count - 1
+		}
+
+		return
+	}
+
+	c.index = (c.index + delta + count) % count
+}
@@ -0,0 +1,67 @@
+package markdown
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/ansi"
+	glamourstyles "github.com/charmbracelet/glamour/styles"
+)
+
+var (
+	renderersMu sync.Mutex
+	renderers   = make(map[int]*glamour.TermRenderer)
+)
+
+// Render renders Markdown source for the terminal, wrapped to width. If the
+// source cannot be rendered it is returned unchanged.
+func Render(source string, width int) string {
+	renderer, err := rendererFor(width)
+	if err != nil {
+		return source
+	}
+
+	rendered, err := renderer.Render(source)
+	if err != nil {
+		return source
+	}
+
+	return strings.Trim(rendered, "\n")
+}
+
+// rendererFor returns a cached renderer, since building one is much slower
+// than rendering with it.
+func rendererFor(width int) (*glamour.TermRenderer, error) {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+
+	if renderer, ok := renderers[width]; ok {
+		return renderer, nil
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStyles(style()),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	renderers[width] = renderer
+
+	return renderer, nil
+}
+
+// style is the dark glamour style without the document margins, which would
+// waste space in the narrow panes notes are shown in.
+func style() ansi.StyleConfig {
+	var noMargin uint
+
+	config := glamourstyles.DarkStyleConfig
+	config.Document.Margin = &noMargin
+	config.Document.BlockPrefix = ""
+	config.Document.BlockSuffix = ""
+
+	return config
+}
@@ -0,0 +1,120 @@
+package mouse
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+var namedKeys = map[string]tea.KeyType{
+	"enter":     tea.KeyEnter,
+	"esc":       tea.KeyEsc,
+	"tab":       tea.KeyTab,
+	"shift+tab": tea.KeyShiftTab,
+	"backspace": tea.KeyBackspace,
+	"delete":    tea.KeyDelete,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"home":      tea.KeyHome,
+	"end":       tea.KeyEnd,
+	"pgup":      tea.KeyPgUp,
+	"pgdown":    tea.KeyPgDown,
+	" ":         tea.KeySpace,
+	"ctrl+a":    tea.KeyCtrlA,
+	"ctrl+c":    tea.KeyCtrlC,
+	"ctrl+d":    tea.KeyCtrlD,
+	"ctrl+p":    tea.KeyCtrlP,
+	"ctrl+s":    tea.KeyCtrlS,
+	"ctrl+u":    tea.KeyCtrlU,
+	"ctrl+z":    tea.KeyCtrlZ,
+}
+
+// HelpBar renders short help with each entry marked as a zone, so clicking an
+// entry can trigger the same action as pressing its key.
+type HelpBar struct {
+	prefix string
+}
+
+func NewHelpBar() HelpBar {
+	return HelpBar{
+		prefix: zone.NewPrefix(),
+	}
+}
+
+// View renders keys like help.Model.View. Only the short help is clickable.
+func (h HelpBar) View(model help.Model, keys help.KeyMap) string {
+	if model.ShowAll {
+		return model.View(keys)
+	}
+
+	separator := model.Styles.ShortSeparator.Inline(true).Render(model.ShortSeparator)
+
+	entries := make([]string, 0)
+	for _, binding := range keys.ShortHelp() {
+		if !binding.Enabled() {
+			continue
+		}
+
+		entry := model.ShortHelpView([]key.Binding{binding})
+		entries = append(entries, zone.Mark(h.entryZoneID(binding), entry))
+	}
+
+	rendered := strings.Join(entries, separator)
+
+	if model.Width > 0 && lipgloss.Width(rendered) > model.Width {
+		return model.ShortHelpView(keys.ShortHelp())
+	}
+
+	return rendered
+}
+
+// Clicked returns the key press for the help entry under the cursor.
+func (h HelpBar) Clicked(keys help.KeyMap, msg tea.MouseMsg) (tea.KeyMsg, bool) {
+	if !IsLeftClick(msg) {
+		return tea.KeyMsg{}, false
+	}
+
+	for _, binding := range keys.ShortHelp() {
+		if !binding.Enabled() || !zone.Get(h.entryZoneID(binding)).InBounds(msg) {
+			continue
+		}
+
+		return KeyMsgFor(binding)
+	}
+
+	return tea.KeyMsg{}, false
+}
+
+func (h HelpBar) entryZoneID(binding key.Binding) string {
+	return h.prefix + "help-" + binding.Help().Key
+}
+
+// KeyMsgFor builds the key press that would trigger binding.
+func KeyMsgFor(binding key.Binding) (tea.KeyMsg, bool) {
+	keys := binding.Keys()
+	if len(keys) == 0 {
+		return tea.KeyMsg{}, false
+	}
+
+	name := keys[0]
+
+	alt := strings.HasPrefix(name, "alt+")
+	name = strings.TrimPrefix(name, "alt+")
+
+	if keyType, ok := namedKeys[name]; ok {
+		return tea.KeyMsg{Type: keyType, Alt: alt}, true
+	}
+
+	if utf8.RuneCountInString(name) == 1 {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name), Alt: alt}, true
+	}
+
+	return tea.KeyMsg{}, false
+}
@@ -0,0 +1,82 @@
+package mouse
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// ListDelegate wraps a list delegate and marks every rendered item as a zone so
+// clicks can be mapped back to list indexes.
+type ListDelegate struct {
+	list.ItemDelegate
+
+	prefix string
+}
+
+func NewListDelegate(delegate list.ItemDelegate) ListDelegate {
+	return ListDelegate{
+		ItemDelegate: delegate,
+		prefix:       zone.NewPrefix(),
+	}
+}
+
+func (d ListDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	var rendered strings.Builder
+	d.ItemDelegate.Render(&rendered, m, index, item)
+
+	// Zones span from the start marker to the end marker, so pad the item to
+	// the full list width to make the whole row clickable.
+	lines := strings.Split(rendered.String(), "\n")
+	for i, line := range lines {
+		if gap := m.Width() - lipgloss.Width(line); gap > 0 {
+			lines[i] = line + strings.Repeat(" ", gap)
+		}
+	}
+
+	fmt.Fprint(w, zone.Mark(d.itemZoneID(index), strings.Join(lines, "\n")))
+}
+
+// HandleMouse scrolls the list with the wheel and selects clicked items. It
+// returns the index of the clicked item, or -1 if no item was clicked.
+func (d ListDelegate) HandleMouse(l *list.Model, msg tea.MouseMsg) int {
+	switch {
+	case IsWheelUp(msg):
+		l.CursorUp()
+
+	case IsWheelDown(msg):
+		l.CursorDown()
+
+	case IsLeftClick(msg):
+		idx := d.ItemAt(*l, msg)
+		if idx >= 0 {
+			l.Select(idx)
+		}
+
+		return idx
+	}
+
+	return -1
+}
+
+// ItemAt returns the index of the visible item under the cursor, or -1.
+func (d ListDelegate) ItemAt(l list.Model, msg tea.MouseMsg) int {
+	start, end := l.Paginator.GetSliceBounds(len(l.VisibleItems()))
+
+	for idx := start; idx < end; idx++ {
+		if zone.Get(d.itemZoneID(idx)).InBounds(msg) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+func (d ListDelegate) itemZoneID(index int) string {
+	return fmt.Sprintf("%sitem-%d", d.prefix, index)
+}
@@ -0,0 +1,44 @@
+package mouse
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const doubleClickInterval = 400 * time.Millisecond
+
+func IsLeftClick(msg tea.MouseMsg) bool {
+	return msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft
+}
+
+func IsWheelUp(msg tea.MouseMsg) bool {
+	return msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonWheelUp
+}
+
+func IsWheelDown(msg tea.MouseMsg) bool {
+	return msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonWheelDown
+}
+
+// ClickTracker detects repeated clicks on the same target.
+type ClickTracker struct {
+	target    string
+	clickedAt time.Time
+}
+
+// Click records a click on target and reports whether it completes a double
+// click.
+func (c *ClickTracker) Click(target string) bool {
+	now := time.Now()
+
+	isDouble := c.target == target && now.Sub(c.clickedAt) <= doubleClickInterval
+
+	c.target = target
+	c.clickedAt = now
+
+	if isDouble {
+		c.target = ""
+	}
+
+	return isDouble
+}
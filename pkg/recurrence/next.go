@@ -0,0 +1,118 @@
+package recurrence
+
+import "time"
+
+// Anchor fills in the parts a rule leaves to its start, such as the weekday
+// of a weekly rule, so that later occurrences keep to them. Without this a
+// monthly rule started on the 31st would move to the 28th after February.
+func (r Rule) Anchor(start time.Time) Rule {
+	switch r.Freq {
+	case Weekly:
+		if len(r.Weekdays) == 0 {
+			r.Weekdays = []time.Weekday{start.Weekday()}
+		}
+
+	case Monthly:
+		if r.MonthDay == 0 {
+			r.MonthDay = start.Day()
+		}
+
+	case Yearly:
+		if r.Month == 0 {
+			r.Month = start.Month()
+		}
+
+		if r.MonthDay == 0 {
+			r.MonthDay = start.Day()
+		}
+	}
+
+	return r
+}
+
+// Next returns the first occurrence on a day after from, treating from as an
+// occurrence of the rule. The occurrence keeps the wall clock time of from,
+// also across daylight saving changes. It reports false once the rule has
+// ended.
+func (r Rule) Next(from time.Time) (time.Time, bool) {
+	r = r.Anchor(from)
+	interval := r.interval()
+
+	var next time.Time
+
+	switch r.Freq {
+	case Daily:
+		next = onDay(from, from.Year(), from.Month(), from.Day()+interval)
+
+	case Weekly:
+		next = r.nextWeekly(from, interval)
+
+	case Monthly:
+		next = onMonthDay(from, from.Year(), from.Month(), r.MonthDay)
+		if !after(next, from) {
+			next = onMonthDay(from, from.Year(), from.Month()+time.Month(interval), r.MonthDay)
+		}
+
+	case Yearly:
+		next = onMonthDay(from, from.Year(), r.Month, r.MonthDay)
+		if !after(next, from) {
+			next = onMonthDay(from, from.Year()+interval, r.Month, r.MonthDay)
+		}
+	}
+
+	if !r.Until.IsZero() && after(next, r.Until) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+func (r Rule) nextWeekly(from time.Time, interval int) time.Time {
+	weekStart := from.Day() - MondayIndex(from.Weekday())
+
+	for _, day := range r.Weekdays {
+		if candidate := onDay(from, from.Year(), from.Month(), weekStart+MondayIndex(day)); after(candidate, from) {
+			return candidate
+		}
+	}
+
+	return onDay(from, from.Year(), from.Month(), weekStart+7*interval+MondayIndex(r.Weekdays[0]))
+}
+
+// onDay returns the given day at the wall clock time of ref. Days and months
+// out of range roll over, the way time.Date normalizes them. A time skipped by
+// a daylight saving change is read with the offset from before the change, so
+// 02:30 on a day that jumps from 02:00 to 03:00 becomes 03:30.
+func onDay(ref time.Time, year int, month time.Month, day int) time.Time {
+	t := time.Date(year, month, day, ref.Hour(), ref.Minute(), ref.Second(), ref.Nanosecond(), ref.Location())
+	if t.Hour() == ref.Hour() && t.Minute() == ref.Minute() {
+		return t
+	}
+
+	_, offset := time.Date(year, month, day-1, 12, 0, 0, 0, ref.Location()).Zone()
+	before := time.FixedZone("", offset)
+
+	return time.Date(year, month, day, ref.Hour(), ref.Minute(), ref.Second(), ref.Nanosecond(), before).In(ref.Location())
+}
+
+// onMonthDay returns the given day of a month, or its last day if the month
+// is too short or day is LastDay.
+func onMonthDay(ref time.Time, year int, month time.Month, day int) time.Time {
+	// Normalize the month first so that December + 1 is January next year.
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	if day == LastDay || day > lastDay {
+		day = lastDay
+	}
+
+	return onDay(ref, first.Year(), first.Month(), day)
+}
+
+// after reports whether a falls on a later calendar day than b.
+func after(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+
+	return time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC).After(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC))
+}
@@ -0,0 +1,189 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("error loading time zone: %v", err)
+	}
+
+	utc := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	ny := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "monthly from Jan 31 keeps the 31st after February",
+			rule:  "monthly",
+			start: utc(2026, time.January, 31, 9, 0),
+			want:  []time.Time{utc(2026, time.February, 28, 9, 0), utc(2026, time.March, 31, 9, 0), utc(2026, time.April, 30, 9, 0)},
+		},
+		{
+			name:  "monthly on the last day",
+			rule:  "monthly on the last day",
+			start: utc(2028, time.January, 31, 9, 0),
+			want:  []time.Time{utc(2028, time.February, 29, 9, 0), utc(2028, time.March, 31, 9, 0), utc(2028, time.April, 30, 9, 0)},
+		},
+		{
+			name:  "monthly across the year end",
+			rule:  "every 2 months on day 30",
+			start: utc(2026, time.November, 30, 0, 0),
+			want:  []time.Time{utc(2027, time.January, 30, 0, 0), utc(2027, time.March, 30, 0, 0)},
+		},
+		{
+			name:  "monthly on a later day of the start month",
+			rule:  "monthly on day 20",
+			start: utc(2026, time.October, 19, 0, 0),
+			want:  []time.Time{utc(2026, time.October, 20, 0, 0), utc(2026, time.November, 20, 0, 0)},
+		},
+		{
+			name:  "yearly from Feb 29 falls back to Feb 28 until the next leap year",
+			rule:  "yearly",
+			start: utc(2024, time.February, 29, 0, 0),
+			want: []time.Time{
+				utc(2025, time.February, 28, 0, 0),
+				utc(2026, time.February, 28, 0, 0),
+				utc(2027, time.February, 28, 0, 0),
+				utc(2028, time.February, 29, 0, 0),
+			},
+		},
+		{
+			name:  "daily keeps the wall clock time across spring forward",
+			rule:  "daily",
+			start: ny(2026, time.March, 7, 9, 0),
+			want:  []time.Time{ny(2026, time.March, 8, 9, 0), ny(2026, time.March, 9, 9, 0)},
+		},
+		{
+			name:  "daily at a time skipped by spring forward moves past the gap",
+			rule:  "daily",
+			start: ny(2026, time.March, 7, 2, 30),
+			want:  []time.Time{ny(2026, time.March, 8, 3, 30), ny(2026, time.March, 9, 3, 30)},
+		},
+		{
+			name:  "daily keeps the wall clock time across fall back",
+			rule:  "daily",
+			start: ny(2026, time.October, 31, 9, 0),
+			want:  []time.Time{ny(2026, time.November, 1, 9, 0), ny(2026, time.November, 2, 9, 0)},
+		},
+		{
+			name:  "daily at a time repeated by fall back",
+			rule:  "daily",
+			start: ny(2026, time.October, 31, 1, 30),
+			want:  []time.Time{ny(2026, time.November, 1, 1, 30), ny(2026, time.November, 2, 1, 30)},
+		},
+		{
+			name:  "every 2 weeks on several weekdays",
+			rule:  "every 2 weeks on mon,wed,fri",
+			start: utc(2026, time.October, 19, 8, 0),
+			want: []time.Time{
+				utc(2026, time.October, 21, 8, 0),
+				utc(2026, time.October, 23, 8, 0),
+				utc(2026, time.November, 2, 8, 0),
+				utc(2026, time.November, 4, 8, 0),
+			},
+		},
+		{
+			name:  "every 2 weeks starting between its weekdays",
+			rule:  "every 2 weeks on mon,fri",
+			start: utc(2026, time.October, 21, 8, 0),
+			want:  []time.Time{utc(2026, time.October, 23, 8, 0), utc(2026, time.November, 2, 8, 0)},
+		},
+		{
+			name:  "weekly keeps the weekday it started on",
+			rule:  "weekly",
+			start: utc(2026, time.October, 22, 0, 0),
+			want:  []time.Time{utc(2026, time.October, 29, 0, 0), utc(2026, time.November, 5, 0, 0)},
+		},
+		{
+			name:  "weekdays skip the weekend",
+			rule:  "weekdays",
+			start: utc(2026, time.October, 23, 0, 0),
+			want:  []time.Time{utc(2026, time.October, 26, 0, 0), utc(2026, time.October, 27, 0, 0)},
+		},
+		{
+			name:  "until ends the rule after its day",
+			rule:  "daily until 2026-10-21",
+			start: utc(2026, time.October, 19, 23, 0),
+			want:  []time.Time{utc(2026, time.October, 20, 23, 0), utc(2026, time.October, 21, 23, 0)},
+		},
+		{
+			name:  "until before the first occurrence",
+			rule:  "monthly until 2026-11-01",
+			start: utc(2026, time.October, 19, 0, 0),
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.rule, err)
+			}
+
+			rule = rule.Anchor(tt.start)
+			from := tt.start
+
+			for _, want := range tt.want {
+				next, ok := rule.Next(from)
+				if !ok {
+					t.Fatalf("Next(%s) ended, want %s", from, want)
+				}
+
+				if !next.Equal(want) || next.Location() != want.Location() {
+					t.Fatalf("Next(%s) = %s, want %s", from, next, want)
+				}
+
+				from = next
+			}
+
+			if next, ok := rule.Next(from); ok && !rule.Until.IsZero() {
+				t.Errorf("Next(%s) = %s, want the rule to have ended", from, next)
+			}
+		})
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"weekly", "weekly on sat"},
+		{"weekly on mon", "weekly on mon"},
+		{"monthly", "monthly on day 31"},
+		{"monthly on the last day", "monthly on the last day"},
+		{"yearly", "yearly in jan on day 31"},
+		{"yearly in mar", "yearly in mar on day 31"},
+		{"daily", "daily"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.rule, err)
+			}
+
+			if got := rule.Anchor(start).String(); got != tt.want {
+				t.Errorf("Anchor(%q) = %q, want %q", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,537 @@
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// LastDay is the MonthDay of rules that repeat on the last day of the month.
+const LastDay = -1
+
+// Syntax is a short description of the rules Parse accepts, for help text.
+const Syntax = "daily, weekdays, weekly on mon,fri, every 2 weeks, monthly on day 15, yearly or an RRULE"
+
+// Rule describes when a task repeats. It supports the subset of RFC 5545
+// RRULEs with FREQ, INTERVAL, BYDAY, BYMONTH, BYMONTHDAY and UNTIL.
+type Rule struct {
+	Freq Frequency
+
+	// Interval is the number of days, weeks, months or years between
+	// occurrences. Zero means one.
+	Interval int
+
+	// Weekdays are the days a weekly rule repeats on. Without them it
+	// repeats on the weekday it started.
+	Weekdays []time.Weekday
+
+	// MonthDay is the day a monthly or yearly rule repeats on, or LastDay.
+	// Zero means the day it started. Months that are too short use their
+	// last day instead.
+	MonthDay int
+
+	// Month is the month a yearly rule repeats in. Zero means the month it
+	// started.
+	Month time.Month
+
+	// Until is the last day an occurrence may fall on. The zero value repeats
+	// forever.
+	Until time.Time
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+var workweek = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// Parse reads a rule written in words, such as "weekly on mon,fri" or
+// "every 2 months until 2026-12-31", or as an RRULE.
+func Parse(value string) (Rule, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Rule{}, fmt.Errorf("empty repeat rule")
+	}
+
+	upper := strings.ToUpper(value)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "FREQ=") {
+		return parseRRule(value)
+	}
+
+	return parseWords(value)
+}
+
+func (r Rule) interval() int {
+	return max(r.Interval, 1)
+}
+
+// String renders the rule in words, in a form Parse reads back.
+func (r Rule) String() string {
+	var b strings.Builder
+
+	interval := r.interval()
+
+	switch r.Freq {
+	case Daily:
+		if interval == 1 {
+			b.WriteString("daily")
+		} else {
+			fmt.Fprintf(&b, "every %d days", interval)
+		}
+
+	case Weekly:
+		switch {
+		case interval == 1 && slices.Equal(r.Weekdays, workweek):
+			b.WriteString("weekdays")
+		case interval == 1:
+			b.WriteString("weekly")
+		default:
+			fmt.Fprintf(&b, "every %d weeks", interval)
+		}
+
+		if len(r.Weekdays) > 0 && !(interval == 1 && slices.Equal(r.Weekdays, workweek)) {
+			names := make([]string, len(r.Weekdays))
+			for idx, day := range r.Weekdays {
+				names[idx] = strings.ToLower(day.String()[:3])
+			}
+
+			b.WriteString(" on " + strings.Join(names, ","))
+		}
+
+	case Monthly:
+		if interval == 1 {
+			b.WriteString("monthly")
+		} else {
+			fmt.Fprintf(&b, "every %d months", interval)
+		}
+
+		b.WriteString(r.monthDayWords())
+
+	case Yearly:
+		if interval == 1 {
+			b.WriteString("yearly")
+		} else {
+			fmt.Fprintf(&b, "every %d years", interval)
+		}
+
+		if r.Month != 0 {
+			b.WriteString(" in " + strings.ToLower(r.Month.String()[:3]))
+		}
+
+		b.WriteString(r.monthDayWords())
+	}
+
+	if !r.Until.IsZero() {
+		b.WriteString(" until " + r.Until.Format(time.DateOnly))
+	}
+
+	return b.String()
+}
+
+func (r Rule) monthDayWords() string {
+	switch {
+	case r.MonthDay == LastDay:
+		return " on the last day"
+	case r.MonthDay > 0:
+		return fmt.Sprintf(" on day %d", r.MonthDay)
+	}
+
+	return ""
+}
+
+// RRule renders the rule as an RFC 5545 recurrence rule.
+func (r Rule) RRule() string {
+	parts := []string{"FREQ=" + [...]string{"DAILY", "WEEKLY", "MONTHLY", "YEARLY"}[r.Freq]}
+
+	if interval := r.interval(); interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(interval))
+	}
+
+	if len(r.Weekdays) > 0 {
+		days := make([]string, len(r.Weekdays))
+		for idx, day := range r.Weekdays {
+			days[idx] = strings.ToUpper(day.String()[:2])
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Month != 0 {
+		parts = append(parts, "BYMONTH="+strconv.Itoa(int(r.Month)))
+	}
+
+	if r.MonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func parseWords(value string) (Rule, error) {
+	var rule Rule
+
+	words := strings.Fields(strings.ToLower(value))
+
+	if idx := slices.Index(words, "until"); idx >= 0 {
+		if idx != len(words)-2 {
+			return Rule{}, fmt.Errorf("until needs a date such as 2006-01-02 at the end of the rule")
+		}
+
+		until, err := time.ParseInLocation(time.DateOnly, words[idx+1], time.Local)
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid until date %q, expected 2006-01-02", words[idx+1])
+		}
+
+		rule.Until = until
+		words = words[:idx]
+	}
+
+	rest, err := parseFrequency(&rule, words)
+	if err != nil {
+		return Rule{}, fmt.Errorf("%w in %q", err, value)
+	}
+
+	for len(rest) > 0 {
+		switch rest[0] {
+		case "on":
+			rest, err = parseOn(&rule, rest[1:])
+		case "in":
+			rest, err = parseIn(&rule, rest[1:])
+		default:
+			err = fmt.Errorf("unexpected %q", rest[0])
+		}
+
+		if err != nil {
+			return Rule{}, fmt.Errorf("%w in %q", err, value)
+		}
+	}
+
+	return rule.normalize()
+}
+
+// parseFrequency reads the start of a rule, such as "daily" or "every 2
+// weeks", and returns the words after it.
+func parseFrequency(rule *Rule, words []string) ([]string, error) {
+	if len(words) == 0 {
+		return nil, fmt.Errorf("missing frequency")
+	}
+
+	switch words[0] {
+	case "daily":
+		rule.Freq = Daily
+		return words[1:], nil
+	case "weekdays":
+		rule.Freq = Weekly
+		rule.Weekdays = workweek
+		return words[1:], nil
+	case "weekly":
+		rule.Freq = Weekly
+		return words[1:], nil
+	case "monthly":
+		rule.Freq = Monthly
+		return words[1:], nil
+	case "yearly", "annually":
+		rule.Freq = Yearly
+		return words[1:], nil
+	case "every":
+	default:
+		return nil, fmt.Errorf("unknown frequency %q, expected daily, weekdays, weekly, monthly, yearly or every", words[0])
+	}
+
+	words = words[1:]
+	rule.Interval = 1
+
+	if len(words) > 0 {
+		if n, err := strconv.Atoi(words[0]); err == nil {
+			if n < 1 {
+				return nil, fmt.Errorf("interval must be at least 1")
+			}
+
+			rule.Interval = n
+			words = words[1:]
+		}
+	}
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("every needs a unit such as day, week, month or year")
+	}
+
+	switch strings.TrimSuffix(words[0], "s") {
+	case "day":
+		rule.Freq = Daily
+	case "weekday":
+		if rule.Interval != 1 {
+			return nil, fmt.Errorf("every weekday cannot have an interval")
+		}
+
+		rule.Freq = Weekly
+		rule.Weekdays = workweek
+	case "week":
+		rule.Freq = Weekly
+	case "month":
+		rule.Freq = Monthly
+	case "year":
+		rule.Freq = Yearly
+	default:
+		if day, ok := ParseWeekday(words[0]); ok && rule.Interval == 1 {
+			rule.Freq = Weekly
+			rule.Weekdays = []time.Weekday{day}
+			break
+		}
+
+		return nil, fmt.Errorf("unknown unit %q, expected day, week, month or year", words[0])
+	}
+
+	return words[1:], nil
+}
+
+// parseOn reads the days after "on": weekdays for weekly rules, or "day 15"
+// or "the last day" for monthly and yearly rules.
+func parseOn(rule *Rule, words []string) ([]string, error) {
+	if rule.Freq == Weekly {
+		days, rest := parseWeekdays(words)
+		if len(days) == 0 {
+			return nil, fmt.Errorf("on needs weekdays such as mon,fri")
+		}
+
+		rule.Weekdays = append(rule.Weekdays, days...)
+
+		return rest, nil
+	}
+
+	if rule.Freq == Daily {
+		return nil, fmt.Errorf("daily rules cannot have days, use weekly on mon,fri instead")
+	}
+
+	if len(words) > 0 && words[0] == "the" {
+		words = words[1:]
+	}
+
+	switch {
+	case len(words) >= 2 && words[0] == "last" && words[1] == "day":
+		rule.MonthDay = LastDay
+		return words[2:], nil
+
+	case len(words) >= 2 && words[0] == "day":
+		day, err := strconv.Atoi(words[1])
+		if err != nil || day < 1 || day > 31 {
+			return nil, fmt.Errorf("invalid day %q, expected 1 to 31", words[1])
+		}
+
+		rule.MonthDay = day
+		return words[2:], nil
+	}
+
+	return nil, fmt.Errorf("on needs day 1 to 31 or the last day")
+}
+
+// parseIn reads the month after "in" for yearly rules.
+func parseIn(rule *Rule, words []string) ([]string, error) {
+	if rule.Freq != Yearly {
+		return nil, fmt.Errorf("only yearly rules can have a month")
+	}
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("in needs a month such as jan")
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		name := strings.ToLower(month.String())
+		if words[0] == name || words[0] == name[:3] {
+			rule.Month = month
+			return words[1:], nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown month %q", words[0])
+}
+
+// parseWeekdays reads weekday names separated by commas, spaces or "and".
+func parseWeekdays(words []string) ([]time.Weekday, []string) {
+	var days []time.Weekday
+
+	for len(words) > 0 {
+		parsed := 0
+
+		for _, name := range strings.Split(words[0], ",") {
+			if name == "" || name == "and" {
+				continue
+			}
+
+			day, ok := ParseWeekday(name)
+			if !ok {
+				return days, words
+			}
+
+			days = append(days, day)
+			parsed++
+		}
+
+		if parsed == 0 && words[0] != "and" && words[0] != "," {
+			break
+		}
+
+		words = words[1:]
+	}
+
+	return days, words
+}
+
+func parseRRule(value string) (Rule, error) {
+	var rule Rule
+
+	value = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+	hasFreq := false
+
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+
+		name, raw, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("invalid RRULE part %q, expected NAME=VALUE", part)
+		}
+
+		switch name {
+		case "FREQ":
+			freqs := map[string]Frequency{"DAILY": Daily, "WEEKLY": Weekly, "MONTHLY": Monthly, "YEARLY": Yearly}
+
+			freq, ok := freqs[raw]
+			if !ok {
+				return Rule{}, fmt.Errorf("unsupported FREQ %q, expected DAILY, WEEKLY, MONTHLY or YEARLY", raw)
+			}
+
+			rule.Freq = freq
+			hasFreq = true
+
+		case "INTERVAL":
+			interval, err := strconv.Atoi(raw)
+			if err != nil || interval < 1 {
+				return Rule{}, fmt.Errorf("invalid INTERVAL %q, expected a positive number", raw)
+			}
+
+			rule.Interval = interval
+
+		case "BYDAY":
+			for _, code := range strings.Split(raw, ",") {
+				day, ok := rruleWeekdays[code]
+				if !ok {
+					return Rule{}, fmt.Errorf("unsupported BYDAY %q, expected weekdays such as MO,FR", code)
+				}
+
+				rule.Weekdays = append(rule.Weekdays, day)
+			}
+
+		case "BYMONTH":
+			month, err := strconv.Atoi(raw)
+			if err != nil || month < 1 || month > 12 {
+				return Rule{}, fmt.Errorf("unsupported BYMONTH %q, expected a single month 1 to 12", raw)
+			}
+
+			rule.Month = time.Month(month)
+
+		case "BYMONTHDAY":
+			day, err := strconv.Atoi(raw)
+			if err != nil || day == 0 || day < LastDay || day > 31 {
+				return Rule{}, fmt.Errorf("unsupported BYMONTHDAY %q, expected a single day 1 to 31 or -1", raw)
+			}
+
+			rule.MonthDay = day
+
+		case "UNTIL":
+			until, err := parseRRuleDate(raw)
+			if err != nil {
+				return Rule{}, err
+			}
+
+			rule.Until = until
+
+		case "WKST":
+			if raw != "MO" {
+				return Rule{}, fmt.Errorf("unsupported WKST %q, weeks start on MO", raw)
+			}
+
+		default:
+			return Rule{}, fmt.Errorf("unsupported RRULE part %s", name)
+		}
+	}
+
+	if !hasFreq {
+		return Rule{}, fmt.Errorf("RRULE needs a FREQ")
+	}
+
+	// A daily rule limited to some weekdays repeats like a weekly one.
+	if rule.Freq == Daily && len(rule.Weekdays) > 0 {
+		if rule.interval() > 1 {
+			return Rule{}, fmt.Errorf("unsupported BYDAY with FREQ=DAILY and an INTERVAL, use FREQ=WEEKLY")
+		}
+
+		rule.Freq = Weekly
+	}
+
+	return rule.normalize()
+}
+
+// parseRRuleDate reads an UNTIL value. Only its date is kept.
+func parseRRuleDate(raw string) (time.Time, error) {
+	for _, layout := range []string{"20060102", "20060102T150405Z", "20060102T150405"} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q, expected a date such as 20061231", raw)
+}
+
+// normalize sorts weekdays from Monday, drops duplicates and rejects parts
+// that do not apply to the frequency.
+func (r Rule) normalize() (Rule, error) {
+	if len(r.Weekdays) > 0 {
+		if r.Freq != Weekly {
+			return Rule{}, fmt.Errorf("only weekly rules can repeat on weekdays")
+		}
+
+		days := slices.Clone(r.Weekdays)
+		slices.SortFunc(days, func(a, b time.Weekday) int {
+			return MondayIndex(a) - MondayIndex(b)
+		})
+
+		r.Weekdays = slices.Compact(days)
+	}
+
+	if r.MonthDay != 0 && r.Freq != Monthly && r.Freq != Yearly {
+		return Rule{}, fmt.Errorf("only monthly and yearly rules can repeat on a day of the month")
+	}
+
+	if r.Month != 0 && r.Freq != Yearly {
+		return Rule{}, fmt.Errorf("only yearly rules can repeat in a month")
+	}
+
+	if r.Interval == 1 {
+		r.Interval = 0
+	}
+
+	return r, nil
+}
@@ -0,0 +1,140 @@
+package recurrence
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Rule
+	}{
+		{"daily", Rule{Freq: Daily}},
+		{"every 3 days", Rule{Freq: Daily, Interval: 3}},
+		{"every 1 day", Rule{Freq: Daily}},
+		{"weekdays", Rule{Freq: Weekly, Weekdays: workweek}},
+		{"every weekday", Rule{Freq: Weekly, Weekdays: workweek}},
+		{"weekly", Rule{Freq: Weekly}},
+		{"weekly on fri,mon", Rule{Freq: Weekly, Weekdays: []time.Weekday{time.Monday, time.Friday}}},
+		{"every 2 weeks on tue and thursday", Rule{Freq: Weekly, Interval: 2, Weekdays: []time.Weekday{time.Tuesday, time.Thursday}}},
+		{"every friday", Rule{Freq: Weekly, Weekdays: []time.Weekday{time.Friday}}},
+		{"Monthly on Day 15", Rule{Freq: Monthly, MonthDay: 15}},
+		{"monthly on the last day", Rule{Freq: Monthly, MonthDay: LastDay}},
+		{"every 2 months until 2026-12-31", Rule{Freq: Monthly, Interval: 2, Until: time.Date(2026, 12, 31, 0, 0, 0, 0, time.Local)}},
+		{"yearly in feb on day 29", Rule{Freq: Yearly, Month: time.February, MonthDay: 29}},
+		{"annually", Rule{Freq: Yearly}},
+
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=FR,MO", Rule{Freq: Weekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Friday}}},
+		{"freq=daily", Rule{Freq: Daily}},
+		{"FREQ=DAILY;BYDAY=MO,WE", Rule{Freq: Weekly, Weekdays: []time.Weekday{time.Monday, time.Wednesday}}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", Rule{Freq: Monthly, MonthDay: LastDay}},
+		{"FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=1;WKST=MO", Rule{Freq: Yearly, Month: time.March, MonthDay: 1}},
+		{"FREQ=WEEKLY;UNTIL=20271231T235959Z", Rule{Freq: Weekly, Until: time.Date(2027, 12, 31, 0, 0, 0, 0, time.Local)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.value, err)
+			}
+
+			if !equalRules(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"", "empty repeat rule"},
+		{"hourly", `unknown frequency "hourly", expected daily, weekdays, weekly, monthly, yearly or every in "hourly"`},
+		{"every 0 days", `interval must be at least 1 in "every 0 days"`},
+		{"every 2", `every needs a unit such as day, week, month or year in "every 2"`},
+		{"every 2 fridays", `unknown unit "fridays", expected day, week, month or year in "every 2 fridays"`},
+		{"daily on mon", `daily rules cannot have days, use weekly on mon,fri instead in "daily on mon"`},
+		{"weekly on", `on needs weekdays such as mon,fri in "weekly on"`},
+		{"monthly on day 32", `invalid day "32", expected 1 to 31 in "monthly on day 32"`},
+		{"monthly in jan", `only yearly rules can have a month in "monthly in jan"`},
+		{"yearly in smarch", `unknown month "smarch" in "yearly in smarch"`},
+		{"weekly until tomorrow", `invalid until date "tomorrow", expected 2006-01-02`},
+		{"weekly until 2026-01-01 on mon", "until needs a date such as 2006-01-02 at the end of the rule"},
+
+		{"FREQ=HOURLY", `unsupported FREQ "HOURLY", expected DAILY, WEEKLY, MONTHLY or YEARLY`},
+		{"RRULE:INTERVAL=2", "RRULE needs a FREQ"},
+		{"FREQ=DAILY;COUNT=3", "unsupported RRULE part COUNT"},
+		{"FREQ=DAILY;INTERVAL", `invalid RRULE part "INTERVAL", expected NAME=VALUE`},
+		{"FREQ=DAILY;INTERVAL=0", `invalid INTERVAL "0", expected a positive number`},
+		{"FREQ=WEEKLY;BYDAY=1MO", `unsupported BYDAY "1MO", expected weekdays such as MO,FR`},
+		{"FREQ=DAILY;INTERVAL=2;BYDAY=MO", "unsupported BYDAY with FREQ=DAILY and an INTERVAL, use FREQ=WEEKLY"},
+		{"FREQ=DAILY;BYMONTHDAY=3", "only monthly and yearly rules can repeat on a day of the month"},
+		{"FREQ=MONTHLY;BYMONTH=3", "only yearly rules can repeat in a month"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-2", `unsupported BYMONTHDAY "-2", expected a single day 1 to 31 or -1`},
+		{"FREQ=DAILY;UNTIL=tomorrow", `invalid UNTIL "TOMORROW", expected a date such as 20061231`},
+		{"FREQ=DAILY;WKST=SU", `unsupported WKST "SU", weeks start on MO`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, err := Parse(tt.value)
+			if err == nil {
+				t.Fatalf("Parse(%q) returned no error, want %q", tt.value, tt.want)
+			}
+
+			if err.Error() != tt.want {
+				t.Errorf("Parse(%q) error = %q, want %q", tt.value, err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+// TestStringRoundTrip checks that String and RRule render rules the way Parse
+// reads them back.
+func TestStringRoundTrip(t *testing.T) {
+	values := []string{
+		"daily",
+		"every 3 days",
+		"weekdays",
+		"every 2 weeks on mon,wed,fri",
+		"monthly on the last day",
+		"every 6 months on day 15",
+		"yearly in feb on day 29 until 2030-01-01",
+	}
+
+	for _, value := range values {
+		t.Run(value, func(t *testing.T) {
+			rule, err := Parse(value)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", value, err)
+			}
+
+			if got := rule.String(); got != value {
+				t.Errorf("Parse(%q).String() = %q", value, got)
+			}
+
+			fromRRule, err := Parse(rule.RRule())
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", rule.RRule(), err)
+			}
+
+			if !equalRules(fromRRule, rule) {
+				t.Errorf("Parse(%q) = %+v, want %+v", rule.RRule(), fromRRule, rule)
+			}
+		})
+	}
+}
+
+func equalRules(a, b Rule) bool {
+	return a.Freq == b.Freq &&
+		a.Interval == b.Interval &&
+		slices.Equal(a.Weekdays, b.Weekdays) &&
+		a.MonthDay == b.MonthDay &&
+		a.Month == b.Month &&
+		a.Until.Equal(b.Until)
+}
@@ -0,0 +1,29 @@
+package recurrence
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseWeekday reads a weekday name or its first three or more letters, such
+// as fri, thurs or Monday.
+func ParseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(name)
+	if len(name) < 3 {
+		return 0, false
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.HasPrefix(strings.ToLower(day.String()), name) {
+			return day, true
+		}
+	}
+
+	return 0, false
+}
+
+// MondayIndex numbers weekdays from Monday, the way weeks start, so Monday is
+// 0 and Sunday 6.
+func MondayIndex(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
@@ -0,0 +1,43 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		name string
+		want time.Weekday
+		ok   bool
+	}{
+		{"mon", time.Monday, true},
+		{"Monday", time.Monday, true},
+		{"tues", time.Tuesday, true},
+		{"thur", time.Thursday, true},
+		{"THURS", time.Thursday, true},
+		{"satur", time.Saturday, true},
+		{"sun", time.Sunday, true},
+		{"su", 0, false},
+		{"", 0, false},
+		{"mondays", 0, false},
+		{"month", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseWeekday(tt.name)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("ParseWeekday(%q) = %s, %v, want %s, %v", tt.name, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestMondayIndex(t *testing.T) {
+	for day, want := range map[time.Weekday]int{time.Monday: 0, time.Wednesday: 2, time.Saturday: 5, time.Sunday: 6} {
+		if got := MondayIndex(day); got != want {
+			t.Errorf("MondayIndex(%s) = %d, want %d", day, got, want)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+	"github.com/mole-squad/soq-tui/pkg/utils"
+)
+
+const (
+	// checkInterval is how often due dates are checked.
+	checkInterval = 30 * time.Second
+
+	// alertDuration is how long an alert stays on screen.
+	alertDuration = 15 * time.Second
+)
+
+type checkMsg struct{}
+
+// dueMsg carries the tasks that came due since the last check.
+type dueMsg struct {
+	alerts []alert
+}
+
+// expireMsg ends the alerts shown with the given ID.
+type expireMsg struct {
+	id int64
+}
+
+type alert struct {
+	task soqapi.TaskDTO
+	due  store.DueDate
+}
+
+var lastAlertID atomic.Int64
+
+var barStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#000000")).
+	Background(styles.Amber).
+	Padding(0, 1)
+
+// Model checks due dates in the background and shows an alert bar when tasks
+// come due. Each due date is reminded of once, also across restarts.
+type Model struct {
+	logger *logger.Logger
+	client *api.Client
+	store  *store.Store
+
+	alerts  []alert
+	alertID int64
+
+	width int
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) Model {
+	return Model{
+		logger: logger,
+		client: client,
+		store:  store,
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return func() tea.Msg {
+		return checkMsg{}
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case checkMsg:
+		return m, tea.Batch(m.check(), tea.Tick(checkInterval, func(time.Time) tea.Msg {
+			return checkMsg{}
+		}))
+
+	case dueMsg:
+		m.alerts = append(msg.alerts, m.alerts...)
+		m.alertID = lastAlertID.Add(1)
+
+		id := m.alertID
+
+		return m, tea.Tick(alertDuration, func(time.Time) tea.Msg {
+			return expireMsg{id: id}
+		})
+
+	case expireMsg:
+		if msg.id == m.alertID {
+			m.alerts = nil
+		}
+	}
+
+	return m, nil
+}
+
+// IsVisible reports whether an alert takes up a line.
+func (m Model) IsVisible() bool {
+	return len(m.alerts) > 0
+}
+
+func (m Model) SetWidth(width int) Model {
+	m.width = width
+
+	return m
+}
+
+func (m Model) View() string {
+	if len(m.alerts) == 0 {
+		return ""
+	}
+
+	now := time.Now()
+
+	var text string
+	if len(m.alerts) == 1 {
+		a := m.alerts[0]
+		text = fmt.Sprintf("⏰ %s · %s", a.task.Summary, taskquery.DueLabel(a.due, now))
+	} else {
+		summaries := make([]string, len(m.alerts))
+		for idx, a := range m.alerts {
+			summaries[idx] = a.task.Summary
+		}
+
+		text = fmt.Sprintf("⏰ %d tasks due · %s", len(m.alerts), strings.Join(summaries, ", "))
+	}
+
+	frameWidth := barStyle.GetHorizontalFrameSize()
+
+	return barStyle.Width(m.width).MaxHeight(1).Render(lipgloss.NewStyle().MaxWidth(m.width - frameWidth).Render(text))
+}
+
+// check finds open tasks that came due and were not reminded of yet, marks
+// them reminded and runs the reminder hook for each. It runs off the UI
+// thread since it calls the API.
+func (m Model) check() tea.Cmd {
+	return func() tea.Msg {
+		if !m.client.IsAuthenticated() {
+			return nil
+		}
+
+		metas, err := m.store.LoadTaskMeta()
+		if err != nil {
+			m.logger.Error("Error loading task metadata", "error", err)
+			return nil
+		}
+
+		now := time.Now()
+		pending := make(map[uint]store.DueDate)
+
+		for taskID, meta := range metas {
+			if meta.Due != nil && !meta.Due.Reminded && !now.Before(meta.Due.RemindAt()) {
+				pending[taskID] = *meta.Due
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+		defer cancel()
+
+		tasks, err := m.client.ListTasks(ctx)
+		if err != nil {
+			m.logger.Error("Error fetching tasks for reminders", "error", err)
+			return nil
+		}
+
+		var alerts []alert
+
+		for _, task := range tasks {
+			if due, ok := pending[task.ID]; ok {
+				alerts = append(alerts, alert{task: task, due: due})
+			}
+		}
+
+		// Resolved tasks are marked too, so they are not looked up again.
+		for taskID, due := range pending {
+			m.markReminded(taskID, due)
+		}
+
+		if len(alerts) == 0 {
+			return nil
+		}
+
+		fmt.Fprint(os.Stderr, "\a")
+		m.runHook(alerts)
+
+		return dueMsg{alerts: alerts}
+	}
+}
+
+// markReminded records the reminder unless the due date changed meanwhile.
+func (m Model) markReminded(taskID uint, due store.DueDate) {
+	err := m.store.UpdateTaskMeta(taskID, func(meta *store.TaskMeta) {
+		if meta.Due != nil && meta.Due.SameAs(due) {
+			meta.Due.Reminded = true
+		}
+	})
+	if err != nil {
+		m.logger.Error("Error recording reminder", "error", err)
+	}
+}
+
+func (m Model) runHook(alerts []alert) {
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	if prefs.ReminderCommand == "" {
+		return
+	}
+
+	for _, a := range alerts {
+		err := utils.RunHook(
+			prefs.ReminderCommand,
+			"SOQ_TASK="+a.task.Summary,
+			fmt.Sprintf("SOQ_TASK_ID=%d", a.task.ID),
+			"SOQ_DUE="+a.due.Deadline().Format(time.RFC3339),
+		)
+		if err != nil {
+			m.logger.Error("Error running reminder command", "error", err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/recurrence"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/subtasks"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+)
+
+// Task resolves a task and, if it repeats, creates its next instance with the
+// same summary, notes and focus area. The subtasks of the next instance start
+// out not done. It returns the ID of the next instance, or 0 if there is none.
+func Task(ctx context.Context, client *api.Client, s *store.Store, logger *logger.Logger, task soqapi.TaskDTO, meta store.TaskMeta) (uint, error) {
+	if _, err := client.ResolveTask(ctx, task.ID); err != nil {
+		return 0, err
+	}
+
+	resolved := store.ResolvedTask{Task: task, ResolvedAt: time.Now()}
+
+	nextID, nextErr := createNextInstance(ctx, client, s, logger, task, meta, resolved.ResolvedAt)
+	resolved.NextID = nextID
+
+	if err := s.RecordResolvedTask(resolved); err != nil {
+		logger.Error("Error recording resolved task", "error", err)
+	}
+
+	if nextErr != nil {
+		return 0, fmt.Errorf("resolved, but error creating the next instance: %w", nextErr)
+	}
+
+	return nextID, nil
+}
+
+// createNextInstance creates the next instance of a repeating task and returns
+// its ID. It returns 0 if the task does not repeat or its rule has ended.
+func createNextInstance(ctx context.Context, client *api.Client, s *store.Store, logger *logger.Logger, task soqapi.TaskDTO, meta store.TaskMeta, now time.Time) (uint, error) {
+	if meta.Recurrence == "" {
+		return 0, nil
+	}
+
+	rule, err := recurrence.Parse(meta.Recurrence)
+	if err != nil {
+		return 0, fmt.Errorf("error reading repeat rule: %w", err)
+	}
+
+	rule, due, ok := nextDue(rule, meta.Due, now)
+	if !ok {
+		return 0, nil
+	}
+
+	dto := soqapi.CreateTaskRequestDTO{
+		Summary:     task.Summary,
+		Notes:       subtasks.Reset(task.Notes),
+		FocusAreaID: task.FocusArea.ID,
+	}
+
+	created, err := client.CreateTask(ctx, &dto)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.UpdateTaskMeta(created.ID, func(next *store.TaskMeta) {
+		next.CreatedAt = now
+		next.UpdatedAt = now
+		next.Priority = meta.Priority
+		next.Tags = meta.Tags
+		next.Due = due
+		next.Recurrence = rule.String()
+	})
+	if err != nil {
+		logger.Error("Error recording task metadata", "error", err)
+	}
+
+	return created.ID, nil
+}
+
+// nextDue works out when the next instance of a repeating task is due. The
+// rule is anchored to the current due date, or to today for tasks without
+// one, and occurrences before today are skipped. It returns the anchored rule
+// to keep with the next instance, and false once the rule has ended.
+func nextDue(rule recurrence.Rule, due *store.DueDate, now time.Time) (recurrence.Rule, *store.DueDate, bool) {
+	current := store.DueDate{At: timetracking.StartOfDay(now)}
+	if due != nil {
+		current = *due
+	}
+
+	rule = rule.Anchor(current.At)
+	today := timetracking.StartOfDay(now)
+
+	next, ok := rule.Next(current.At)
+	for ok && next.Before(today) {
+		next, ok = rule.Next(next)
+	}
+
+	if !ok {
+		return rule, nil, false
+	}
+
+	return rule, &store.DueDate{At: next, HasTime: current.HasTime}, true
+}
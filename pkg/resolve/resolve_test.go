@@ -0,0 +1,102 @@
+package resolve
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mole-squad/soq-tui/pkg/recurrence"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+func TestNextDue(t *testing.T) {
+	date := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		due      *store.DueDate
+		now      time.Time
+		wantRule string
+		wantDue  *store.DueDate
+	}{
+		{
+			name:     "monthly rule keeps the day it was due on",
+			rule:     "monthly",
+			due:      &store.DueDate{At: date(time.January, 31, 0)},
+			now:      date(time.January, 31, 10),
+			wantRule: "monthly on day 31",
+			wantDue:  &store.DueDate{At: date(time.February, 28, 0)},
+		},
+		{
+			name:     "anchored rule carries on from a short month",
+			rule:     "monthly on day 31",
+			due:      &store.DueDate{At: date(time.February, 28, 0)},
+			now:      date(time.February, 28, 10),
+			wantRule: "monthly on day 31",
+			wantDue:  &store.DueDate{At: date(time.March, 31, 0)},
+		},
+		{
+			name:     "weekly rule without a due date is anchored to today",
+			rule:     "weekly",
+			now:      date(time.October, 21, 15),
+			wantRule: "weekly on wed",
+			wantDue:  &store.DueDate{At: date(time.October, 28, 0)},
+		},
+		{
+			name:     "due time is kept",
+			rule:     "every 2 days",
+			due:      &store.DueDate{At: date(time.October, 19, 9), HasTime: true},
+			now:      date(time.October, 19, 10),
+			wantRule: "every 2 days",
+			wantDue:  &store.DueDate{At: date(time.October, 21, 9), HasTime: true},
+		},
+		{
+			name:     "occurrences before today are skipped",
+			rule:     "daily",
+			due:      &store.DueDate{At: date(time.October, 1, 0)},
+			now:      date(time.October, 19, 10),
+			wantRule: "daily",
+			wantDue:  &store.DueDate{At: date(time.October, 19, 0)},
+		},
+		{
+			name:     "rule that ended",
+			rule:     "daily until 2026-10-05",
+			due:      &store.DueDate{At: date(time.October, 1, 0)},
+			now:      date(time.October, 19, 10),
+			wantRule: "daily until 2026-10-05",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := recurrence.Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.rule, err)
+			}
+
+			gotRule, gotDue, ok := nextDue(rule, tt.due, tt.now)
+
+			if got := gotRule.String(); got != tt.wantRule {
+				t.Errorf("rule = %q, want %q", got, tt.wantRule)
+			}
+
+			if tt.wantDue == nil {
+				if ok {
+					t.Errorf("nextDue returned %+v, want the rule to have ended", gotDue)
+				}
+
+				return
+			}
+
+			if !ok || gotDue == nil {
+				t.Fatalf("nextDue ended, want %+v", tt.wantDue)
+			}
+
+			if !gotDue.SameAs(*tt.wantDue) {
+				t.Errorf("due = %+v, want %+v", gotDue, tt.wantDue)
+			}
+		})
+	}
+}
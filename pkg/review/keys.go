@@ -0,0 +1,103 @@
+package review
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Keep    key.Binding
+	Resolve key.Binding
+	Snooze  key.Binding
+	Move    key.Binding
+	Edit    key.Binding
+	Delete  key.Binding
+	Stop    key.Binding
+
+	NextOption    key.Binding
+	PrevOption    key.Binding
+	ConfirmOption key.Binding
+	CancelOption  key.Binding
+
+	Done     key.Binding
+	ShowHelp key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Keep: key.NewBinding(
+			key.WithKeys("k", "enter"),
+			key.WithHelp("k", "keep"),
+		),
+		Resolve: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "resolve"),
+		),
+		Snooze: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "snooze"),
+		),
+		Move: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "move focus area"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "delete"),
+		),
+		Stop: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "stop review"),
+		),
+		NextOption: key.NewBinding(
+			key.WithKeys("right", "l", "tab"),
+			key.WithHelp("→", "next"),
+		),
+		PrevOption: key.NewBinding(
+			key.WithKeys("left", "h", "shift+tab"),
+			key.WithHelp("←", "previous"),
+		),
+		ConfirmOption: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "choose"),
+		),
+		CancelOption: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		Done: key.NewBinding(
+			key.WithKeys("enter", "esc"),
+			key.WithHelp("enter", "task list"),
+			key.WithDisabled(),
+		),
+		ShowHelp: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
+	}
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Keep, k.Resolve, k.Snooze, k.Move, k.Edit, k.Delete, k.Done, k.ShowHelp}
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Keep, k.Resolve, k.Snooze},
+		{k.Move, k.Edit, k.Delete},
+		{k.Stop, k.Done, k.ShowHelp},
+	}
+}
+
+// setFinished enables the key that leaves the summary instead of those for
+// reviewing a task.
+func (k *keyMap) setFinished(finished bool) {
+	bindings := []*key.Binding{&k.Keep, &k.Resolve, &k.Snooze, &k.Move, &k.Edit, &k.Delete, &k.Stop}
+
+	for _, binding := range bindings {
+		binding.SetEnabled(!finished)
+	}
+
+	k.Done.SetEnabled(finished)
+}
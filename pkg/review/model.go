@@ -0,0 +1,492 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/confirm"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/resolve"
+	"github.com/mole-squad/soq-tui/pkg/snooze"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/taskops"
+)
+
+type outcome int
+
+const (
+	outcomeKept outcome = iota
+	outcomeResolved
+	outcomeSnoozed
+	outcomeMoved
+	outcomeEdited
+	outcomeDeleted
+)
+
+// change is what the review did with a task. detail says more, such as the
+// focus area it moved to.
+type change struct {
+	task    soqapi.TaskDTO
+	outcome outcome
+	detail  string
+}
+
+type picker int
+
+const (
+	pickerNone picker = iota
+	pickerSnooze
+	pickerMove
+)
+
+// Model walks through the open tasks added or changed since the last review,
+// one at a time, then sums up what changed. The review is recorded once every
+// task has been seen.
+type Model struct {
+	client *api.Client
+	logger *logger.Logger
+	store  *store.Store
+
+	last       store.Review
+	openIDs    []uint
+	tasks      []soqapi.TaskDTO
+	taskMeta   map[uint]store.TaskMeta
+	areaMeta   map[uint]store.FocusAreaMeta
+	focusAreas []soqapi.FocusAreaDTO
+	now        time.Time
+
+	cursor   int
+	changes  []change
+	finished bool
+	status   string
+
+	// editing is set while the current task is open in the task form, and
+	// editedAt is when it was saved before, to tell a save from a cancel.
+	editing  bool
+	editedAt time.Time
+
+	picking picker
+	pickIdx int
+
+	confirm        confirm.Model
+	pendingOutcome outcome
+
+	keys       keyMap
+	help       help.Model
+	helpBar    mouse.HelpBar
+	zonePrefix string
+
+	height int
+	width  int
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	return Model{
+		client:     client,
+		logger:     logger,
+		store:      store,
+		confirm:    confirm.New(),
+		keys:       newKeyMap(),
+		help:       help.New(),
+		helpBar:    mouse.NewHelpBar(),
+		zonePrefix: zone.NewPrefix(),
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.Width = msg.Width
+
+	case tea.KeyMsg:
+		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+	}
+
+	return m, nil
+}
+
+func (m Model) Blur() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+// Focus starts a new review, unless the view comes back from editing a task
+// of the review under way.
+func (m Model) Focus() (tea.Model, tea.Cmd) {
+	m.status = ""
+
+	if m.editing {
+		return m.onEditDone()
+	}
+
+	return m.start()
+}
+
+func (m Model) start() (Model, tea.Cmd) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	tasks, err := m.client.ListTasks(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching tasks: %w", err))
+	}
+
+	m.focusAreas, err = m.client.ListFocusAreas(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching focus areas: %w", err))
+	}
+
+	m.taskMeta, err = m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	m.areaMeta, err = m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
+	m.last, err = m.store.LoadReview()
+	if err != nil {
+		m.logger.Error("Error loading last review", "error", err)
+	}
+
+	m.now = time.Now()
+	m.openIDs = make([]uint, 0, len(tasks))
+	m.tasks = make([]soqapi.TaskDTO, 0, len(tasks))
+
+	for _, task := range tasks {
+		m.openIDs = append(m.openIDs, task.ID)
+
+		meta := m.taskMeta[task.ID]
+		if !meta.IsSnoozed(m.now) && m.last.NeedsReview(task.ID, meta) {
+			m.tasks = append(m.tasks, task)
+		}
+	}
+
+	m.cursor = 0
+	m.changes = nil
+	m.picking = pickerNone
+	m.confirm = m.confirm.Close()
+	m.finished = false
+	m.keys.setFinished(false)
+
+	if len(m.tasks) == 0 {
+		return m.finish()
+	}
+
+	return m, nil
+}
+
+// finish shows the summary, and records the review if every task was seen.
+func (m Model) finish() (Model, tea.Cmd) {
+	m.finished = true
+	m.picking = pickerNone
+	m.keys.setFinished(true)
+
+	if m.stopped() {
+		return m, nil
+	}
+
+	if err := m.store.SaveReview(store.Review{At: time.Now(), TaskIDs: m.openIDs}); err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	return m, nil
+}
+
+// stopped reports whether the review ended before every task was seen.
+func (m Model) stopped() bool {
+	return m.cursor < len(m.tasks)
+}
+
+func (m Model) currentTask() soqapi.TaskDTO {
+	return m.tasks[m.cursor]
+}
+
+// next records what happened to the current task and moves on to the next.
+func (m Model) next(outcome outcome, detail string) (Model, tea.Cmd) {
+	m.changes = append(m.changes, change{task: m.currentTask(), outcome: outcome, detail: detail})
+	m.cursor++
+	m.picking = pickerNone
+
+	if m.cursor >= len(m.tasks) {
+		return m.finish()
+	}
+
+	return m, nil
+}
+
+func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.status = ""
+
+	if m.confirm.IsOpen() {
+		return m.onConfirmMsg(msg)
+	}
+
+	if m.picking != pickerNone {
+		return m.onPickerKeyMsg(msg)
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Done):
+		return m, common.AppStateCmd(common.AppStateTaskList)
+
+	case key.Matches(msg, m.keys.Keep):
+		return m.next(outcomeKept, "")
+
+	case key.Matches(msg, m.keys.Resolve):
+		return m.request(outcomeResolved)
+
+	case key.Matches(msg, m.keys.Delete):
+		return m.request(outcomeDeleted)
+
+	case key.Matches(msg, m.keys.Snooze):
+		m.picking = pickerSnooze
+		m.pickIdx = 0
+
+	case key.Matches(msg, m.keys.Move):
+		if len(m.moveTargets()) == 0 {
+			m.status = "There is no other focus area to move the task to"
+			return m, nil
+		}
+
+		m.picking = pickerMove
+		m.pickIdx = 0
+
+	case key.Matches(msg, m.keys.Edit):
+		return m.onEdit()
+
+	case key.Matches(msg, m.keys.Stop):
+		return m.finish()
+
+	case key.Matches(msg, m.keys.ShowHelp):
+		m.help.ShowAll = !m.help.ShowAll
+	}
+
+	return m, nil
+}
+
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if m.confirm.IsOpen() {
+		return m.onConfirmMsg(msg)
+	}
+
+	if keyMsg, ok := m.helpBar.Clicked(m.keys, msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	if m.picking == pickerNone || !mouse.IsLeftClick(msg) {
+		return m, nil
+	}
+
+	for idx := 0; idx < m.optionCount(); idx++ {
+		if zone.Get(m.optionZoneID(idx)).InBounds(msg) {
+			m.pickIdx = idx
+			return m.choose()
+		}
+	}
+
+	return m, nil
+}
+
+// request resolves or deletes the current task, asking first if the action is
+// set to need confirmation.
+func (m Model) request(outcome outcome) (Model, tea.Cmd) {
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	confirmAction, verb := store.ConfirmResolveTask, "Resolve"
+	if outcome == outcomeDeleted {
+		confirmAction, verb = store.ConfirmDeleteTask, "Delete"
+	}
+
+	if !prefs.ShouldConfirm(confirmAction) {
+		return m.apply(outcome)
+	}
+
+	m.pendingOutcome = outcome
+	m.confirm = m.confirm.Ask(fmt.Sprintf("%s %q?", verb, m.currentTask().Summary))
+
+	return m, nil
+}
+
+func (m Model) onConfirmMsg(msg tea.Msg) (Model, tea.Cmd) {
+	var answer confirm.Answer
+	m.confirm, answer = m.confirm.Update(msg)
+
+	if answer != confirm.Confirmed {
+		return m, nil
+	}
+
+	return m.apply(m.pendingOutcome)
+}
+
+func (m Model) apply(outcome outcome) (Model, tea.Cmd) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	task := m.currentTask()
+
+	if outcome == outcomeDeleted {
+		if err := m.client.DeleteTask(ctx, task.ID); err != nil {
+			return m, common.NewErrorMsg(fmt.Errorf("error deleting task: %w", err))
+		}
+
+		if err := m.store.DeleteTaskMeta(task.ID); err != nil {
+			m.logger.Error("Error deleting task metadata", "error", err)
+		}
+
+		return m.next(outcomeDeleted, "")
+	}
+
+	nextID, err := resolve.Task(ctx, m.client, m.store, m.logger, task, m.taskMeta[task.ID])
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error resolving task: %w", err))
+	}
+
+	// The next instance of a repeating task was not added since the review.
+	if nextID != 0 {
+		m.openIDs = append(m.openIDs, nextID)
+	}
+
+	return m.next(outcomeResolved, "")
+}
+
+func (m Model) onPickerKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	count := m.optionCount()
+
+	switch {
+	case key.Matches(msg, m.keys.NextOption):
+		m.pickIdx = (m.pickIdx + 1) % count
+
+	case key.Matches(msg, m.keys.PrevOption):
+		m.pickIdx = (m.pickIdx - 1 + count) % count
+
+	case key.Matches(msg, m.keys.ConfirmOption):
+		return m.choose()
+
+	case key.Matches(msg, m.keys.CancelOption):
+		m.picking = pickerNone
+	}
+
+	return m, nil
+}
+
+func (m Model) optionCount() int {
+	if m.picking == pickerMove {
+		return len(m.moveTargets())
+	}
+
+	return len(snooze.Presets)
+}
+
+// choose snoozes or moves the current task with the picked option.
+func (m Model) choose() (Model, tea.Cmd) {
+	task := m.currentTask()
+
+	if m.picking == pickerSnooze {
+		until := snooze.Presets[m.pickIdx].Until(m.now)
+
+		err := m.store.UpdateTaskMeta(task.ID, func(meta *store.TaskMeta) {
+			meta.SnoozedUntil = &until
+		})
+		if err != nil {
+			return m, common.NewErrorMsg(err)
+		}
+
+		return m.next(outcomeSnoozed, "until "+until.Format(snooze.DayFormat))
+	}
+
+	focusArea := m.moveTargets()[m.pickIdx]
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	if err := taskops.Move(ctx, m.client, m.store, m.logger, task, focusArea.ID); err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error moving task: %w", err))
+	}
+
+	return m.next(outcomeMoved, "to "+focusArea.Name)
+}
+
+// moveTargets lists the focus areas the current task can move to.
+func (m Model) moveTargets() []soqapi.FocusAreaDTO {
+	current := m.currentTask().FocusArea.ID
+	targets := make([]soqapi.FocusAreaDTO, 0, len(m.focusAreas))
+
+	for _, focusArea := range m.focusAreas {
+		if focusArea.ID != current {
+			targets = append(targets, focusArea)
+		}
+	}
+
+	return targets
+}
+
+func (m Model) onEdit() (Model, tea.Cmd) {
+	task := m.currentTask()
+
+	m.editing = true
+	m.editedAt = m.taskMeta[task.ID].UpdatedAt
+
+	return m, tea.Sequence(
+		common.NewSelectTaskMsgFrom(task, common.AppStateReview),
+		common.AppStateCmd(common.AppStateTaskForm),
+	)
+}
+
+// onEditDone picks up the edited task, and moves on if it was saved.
+func (m Model) onEditDone() (Model, tea.Cmd) {
+	m.editing = false
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	tasks, err := m.client.ListTasks(ctx)
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("error fetching tasks: %w", err))
+	}
+
+	m.taskMeta, err = m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	task := m.currentTask()
+
+	for _, fresh := range tasks {
+		if fresh.ID == task.ID {
+			m.tasks[m.cursor] = fresh
+		}
+	}
+
+	if !m.taskMeta[task.ID].UpdatedAt.After(m.editedAt) {
+		return m, nil
+	}
+
+	return m.next(outcomeEdited, "")
+}
+
+func (m Model) optionZoneID(idx int) string {
+	return fmt.Sprintf("%soption-%d", m.zonePrefix, idx)
+}
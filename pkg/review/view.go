@@ -0,0 +1,319 @@
+package review
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/markdown"
+	"github.com/mole-squad/soq-tui/pkg/snooze"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+const lastReviewFormat = "Mon Jan 2 15:04"
+
+var (
+	titleStyle = list.DefaultStyles().Title
+
+	headerStyle = lipgloss.NewStyle().PaddingBottom(1)
+
+	cardStyle = styles.BorderStyle.Padding(0, 1)
+
+	summaryStyle = lipgloss.NewStyle().Bold(true)
+
+	detailStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+
+	reasonStyle = lipgloss.NewStyle().Foreground(styles.Amber)
+
+	emptyStyle = lipgloss.NewStyle().
+			Foreground(styles.DarkGray).
+			Italic(true)
+
+	statusStyle = lipgloss.NewStyle().Foreground(styles.Amber)
+
+	pickerStyle = lipgloss.NewStyle().Padding(1, 0, 0, 0)
+
+	activeOptionStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("#FFFFFF")).
+				Background(styles.HotPink).
+				Padding(0, 1)
+
+	inactiveOptionStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Padding(0, 1)
+
+	outcomeStyles = map[outcome]lipgloss.Style{
+		outcomeResolved: lipgloss.NewStyle().Foreground(styles.HotPink),
+		outcomeSnoozed:  lipgloss.NewStyle().Foreground(styles.DarkGray),
+		outcomeMoved:    lipgloss.NewStyle().Foreground(styles.Amber),
+		outcomeEdited:   lipgloss.NewStyle().Foreground(styles.Amber),
+		outcomeDeleted:  lipgloss.NewStyle().Foreground(styles.ErrorRed),
+	}
+)
+
+func (o outcome) verb() string {
+	switch o {
+	case outcomeResolved:
+		return "resolved"
+	case outcomeSnoozed:
+		return "snoozed"
+	case outcomeMoved:
+		return "moved"
+	case outcomeEdited:
+		return "edited"
+	case outcomeDeleted:
+		return "deleted"
+	}
+
+	return "kept"
+}
+
+func (o outcome) icon() string {
+	switch o {
+	case outcomeResolved:
+		return "✓"
+	case outcomeSnoozed:
+		return "☾"
+	case outcomeMoved:
+		return "→"
+	case outcomeEdited:
+		return "✎"
+	case outcomeDeleted:
+		return "✗"
+	}
+
+	return ""
+}
+
+func (m Model) View() string {
+	help := m.renderHelp()
+
+	var header, body string
+
+	if m.finished {
+		header, body = m.renderSummary()
+	} else {
+		header = titleStyle.Render("Review") + detailStyle.Render(fmt.Sprintf("  %d of %d · %s", m.cursor+1, len(m.tasks), m.sinceLabel()))
+		body = m.renderTask(m.currentTask())
+	}
+
+	// The header takes two lines.
+	bodyHeight := max(1, m.height-2-lipgloss.Height(help))
+	body = lipgloss.NewStyle().Height(bodyHeight).MaxHeight(bodyHeight).Render(body)
+
+	return lipgloss.JoinVertical(lipgloss.Left, headerStyle.Render(header), body, help)
+}
+
+func (m Model) sinceLabel() string {
+	if m.last.At.IsZero() {
+		return "first review, every open task"
+	}
+
+	return "added or changed since " + m.last.At.Format(lastReviewFormat)
+}
+
+func (m Model) renderTask(task soqapi.TaskDTO) string {
+	meta := m.taskMeta[task.ID]
+	areaMeta := m.areaMeta[task.FocusArea.ID]
+
+	details := []string{styles.FocusAreaBadge(task.FocusArea.Name, areaMeta.Glyph, areaMeta.Color)}
+
+	if meta.Priority != store.PriorityNone {
+		details = append(details, detailStyle.Render(meta.Priority.Label()+" priority"))
+	}
+
+	if meta.Due != nil {
+		details = append(details, detailStyle.Render(taskquery.DueLabel(*meta.Due, m.now)))
+	}
+
+	lines := []string{
+		summaryStyle.Render(task.Summary),
+		strings.Join(details, detailStyle.Render(" · ")),
+	}
+
+	if len(meta.Tags) > 0 {
+		chips := make([]string, len(meta.Tags))
+		for idx, tag := range meta.Tags {
+			chips[idx] = styles.TagChip(tag)
+		}
+
+		lines = append(lines, strings.Join(chips, " "))
+	}
+
+	if reason := m.reason(task, meta); reason != "" {
+		lines = append(lines, reasonStyle.Render(reason))
+	}
+
+	frameWidth, _ := cardStyle.GetFrameSize()
+	width := max(20, m.width-frameWidth)
+
+	if notes := strings.TrimSpace(task.Notes); notes != "" {
+		lines = append(lines, "", markdown.Render(notes, width))
+	}
+
+	card := cardStyle.Width(width).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return lipgloss.JoinVertical(lipgloss.Left, card, m.renderPicker())
+}
+
+// reason says why a task comes up for review.
+func (m Model) reason(task soqapi.TaskDTO, meta store.TaskMeta) string {
+	switch {
+	case m.last.At.IsZero():
+		return ""
+	case !slices.Contains(m.last.TaskIDs, task.ID), meta.CreatedAt.After(m.last.At):
+		return "Added since the last review"
+	case meta.UpdatedAt.After(m.last.At):
+		return "Changed since the last review"
+	}
+
+	return "Back from a snooze since the last review"
+}
+
+func (m Model) renderPicker() string {
+	var (
+		prompt string
+		labels []string
+	)
+
+	switch m.picking {
+	case pickerSnooze:
+		prompt = "Snooze until:"
+
+		for _, preset := range snooze.Presets {
+			labels = append(labels, preset.Label(m.now))
+		}
+
+	case pickerMove:
+		prompt = "Move to:"
+
+		for _, focusArea := range m.moveTargets() {
+			label := focusArea.Name
+			if glyph := m.areaMeta[focusArea.ID].Glyph; glyph != "" {
+				label = glyph + " " + label
+			}
+
+			labels = append(labels, label)
+		}
+
+	default:
+		return ""
+	}
+
+	tabs := make([]string, len(labels))
+
+	for idx, label := range labels {
+		style := inactiveOptionStyle
+		if idx == m.pickIdx {
+			style = activeOptionStyle
+		}
+
+		tabs[idx] = zone.Mark(m.optionZoneID(idx), style.Render(label))
+	}
+
+	return pickerStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.InputLabelStyle.Render(prompt),
+		strings.Join(tabs, " "),
+		styles.InputHelpStyle.Render("←/→ choose • enter confirm • esc cancel"),
+	))
+}
+
+// renderSummary sums up the review: how many tasks had each outcome, then the
+// tasks that changed.
+func (m Model) renderSummary() (string, string) {
+	if len(m.tasks) == 0 {
+		message := "There are no open tasks to review."
+		if !m.last.At.IsZero() {
+			message = fmt.Sprintf("Nothing was added or changed since the last review on %s.", m.last.At.Format(lastReviewFormat))
+		}
+
+		return titleStyle.Render("Review complete"), emptyStyle.Render(message)
+	}
+
+	title := "Review complete"
+	lines := []string{fmt.Sprintf("Reviewed %s: %s.", pluralTasks(len(m.changes)), m.countOutcomes())}
+
+	if m.stopped() {
+		title = "Review stopped"
+		lines[0] = fmt.Sprintf("Stopped after %d of %d tasks: %s.", len(m.changes), len(m.tasks), m.countOutcomes())
+		lines = append(lines, detailStyle.Render("Only a finished review is recorded, so the next one starts over."))
+
+		if len(m.changes) == 0 {
+			lines[0] = fmt.Sprintf("Stopped before reviewing any of %s.", pluralTasks(len(m.tasks)))
+		}
+	}
+
+	var changed []string
+
+	for _, change := range m.changes {
+		if change.outcome == outcomeKept {
+			continue
+		}
+
+		line := fmt.Sprintf("  %s  %s", outcomeStyles[change.outcome].Render(fmt.Sprintf("%s %-8s", change.outcome.icon(), change.outcome.verb())), change.task.Summary)
+		if change.detail != "" {
+			line += detailStyle.Render(" " + change.detail)
+		}
+
+		changed = append(changed, lipgloss.NewStyle().MaxWidth(m.width).Render(line))
+	}
+
+	if len(changed) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, changed...)
+	}
+
+	return titleStyle.Render(title), strings.Join(lines, "\n")
+}
+
+// countOutcomes lists how many tasks had each outcome, such as "3 kept,
+// 1 resolved".
+func (m Model) countOutcomes() string {
+	counts := make(map[outcome]int)
+	for _, change := range m.changes {
+		counts[change.outcome]++
+	}
+
+	var parts []string
+
+	for _, outcome := range []outcome{outcomeKept, outcomeResolved, outcomeSnoozed, outcomeMoved, outcomeEdited, outcomeDeleted} {
+		if counts[outcome] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[outcome], outcome.verb()))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "nothing changed"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func (m Model) renderHelp() string {
+	help := m.helpBar.View(m.help, m.keys)
+
+	switch {
+	case m.confirm.IsOpen():
+		help = m.confirm.View()
+	case m.status != "":
+		help = lipgloss.JoinVertical(lipgloss.Left, statusStyle.Render(m.status), help)
+	}
+
+	return help
+}
+
+func pluralTasks(count int) string {
+	if count == 1 {
+		return "1 task"
+	}
+
+	return fmt.Sprintf("%d tasks", count)
+}
@@ -0,0 +1,85 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+type confirmOption struct {
+	action string
+	label  string
+}
+
+var confirmOptions = []confirmOption{
+	{action: store.ConfirmDeleteTask, label: "Deleting a task"},
+	{action: store.ConfirmResolveTask, label: "Resolving a task"},
+	{action: store.ConfirmDeleteFocusArea, label: "Deleting a focus area"},
+}
+
+var (
+	sectionStyle = lipgloss.NewStyle().Padding(0, 0, 1, 0)
+
+	selectedOptionStyle = lipgloss.NewStyle().Foreground(styles.HotPink)
+)
+
+func (m Model) toggleConfirmation(idx int) (Model, tea.Cmd) {
+	action := confirmOptions[idx].action
+
+	err := m.store.UpdatePrefs(func(prefs *store.Prefs) {
+		if prefs.Confirmations == nil {
+			prefs.Confirmations = make(map[string]bool)
+		}
+
+		prefs.Confirmations[action] = !prefs.ShouldConfirm(action)
+	})
+	if err != nil {
+		m.logger.Error("Error saving preferences", "error", err)
+	}
+
+	m.cursor = idx
+	m.loadPrefs()
+
+	return m, nil
+}
+
+// clickedConfirmation returns the option under the cursor.
+func (m Model) clickedConfirmation(msg tea.MouseMsg) (int, bool) {
+	if !mouse.IsLeftClick(msg) {
+		return 0, false
+	}
+
+	for idx, option := range confirmOptions {
+		if zone.Get(m.zonePrefix + option.action).InBounds(msg) {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+func (m Model) renderConfirmations() string {
+	lines := []string{styles.InputLabelStyle.Render("Ask before")}
+
+	for idx, option := range confirmOptions {
+		check := " "
+		if m.prefs.ShouldConfirm(option.action) {
+			check = "x"
+		}
+
+		line := fmt.Sprintf("  [%s] %s", check, option.label)
+		if idx == m.cursor {
+			line = selectedOptionStyle.Render("› " + strings.TrimPrefix(line, "  "))
+		}
+
+		lines = append(lines, zone.Mark(m.zonePrefix+option.action, line))
+	}
+
+	return sectionStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,115 @@
+package settings
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+type durationOption struct {
+	id    string
+	label string
+	min   int
+	max   int
+	step  int
+
+	get func(prefs store.Prefs) int
+	set func(prefs *store.Prefs, minutes int)
+}
+
+var durationOptions = []durationOption{
+	{
+		id:    "work",
+		label: "Work",
+		min:   5,
+		max:   120,
+		step:  5,
+		get:   store.Prefs.WorkMinutes,
+		set:   func(prefs *store.Prefs, minutes int) { prefs.FocusWorkMinutes = minutes },
+	},
+	{
+		id:    "break",
+		label: "Break",
+		min:   1,
+		max:   60,
+		step:  1,
+		get:   store.Prefs.BreakMinutes,
+		set:   func(prefs *store.Prefs, minutes int) { prefs.FocusBreakMinutes = minutes },
+	},
+}
+
+// durationIdx returns the timer option under the cursor. The timer options
+// follow the confirmation options.
+func (m Model) durationIdx() (int, bool) {
+	idx := m.cursor - len(confirmOptions)
+
+	return idx, idx >= 0 && idx < len(durationOptions)
+}
+
+func (m Model) adjustDuration(idx int, direction int) (Model, tea.Cmd) {
+	option := durationOptions[idx]
+
+	minutes := option.get(m.prefs) + direction*option.step
+	minutes = max(option.min, min(option.max, minutes))
+
+	err := m.store.UpdatePrefs(func(prefs *store.Prefs) {
+		option.set(prefs, minutes)
+	})
+	if err != nil {
+		m.logger.Error("Error saving preferences", "error", err)
+	}
+
+	m.cursor = len(confirmOptions) + idx
+	m.loadPrefs()
+
+	return m, nil
+}
+
+// clickedDuration returns the timer option and the direction of the arrow
+// under the cursor.
+func (m Model) clickedDuration(msg tea.MouseMsg) (int, int, bool) {
+	if !mouse.IsLeftClick(msg) {
+		return 0, 0, false
+	}
+
+	for idx, option := range durationOptions {
+		if zone.Get(m.zonePrefix + option.id + "-dec").InBounds(msg) {
+			return idx, -1, true
+		}
+
+		if zone.Get(m.zonePrefix + option.id + "-inc").InBounds(msg) {
+			return idx, 1, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+func (m Model) renderFocusTimer() string {
+	lines := []string{styles.InputLabelStyle.Render("Focus timer")}
+
+	selected, hasSelected := m.durationIdx()
+
+	for idx, option := range durationOptions {
+		value := fmt.Sprintf(
+			"%s %3d min %s",
+			zone.Mark(m.zonePrefix+option.id+"-dec", "‹"),
+			option.get(m.prefs),
+			zone.Mark(m.zonePrefix+option.id+"-inc", "›"),
+		)
+
+		line := fmt.Sprintf("  %-6s %s", option.label, value)
+		if hasSelected && idx == selected {
+			line = selectedOptionStyle.Render(fmt.Sprintf("› %-6s ", option.label)) + value
+		}
+
+		lines = append(lines, line)
+	}
+
+	return sectionStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
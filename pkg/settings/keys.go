@@ -5,6 +5,12 @@ import "github.com/charmbracelet/bubbles/key"
 type keyMap struct {
 	Back       key.Binding
 	FocusAreas key.Binding
+
+	Up       key.Binding
+	Down     key.Binding
+	Toggle   key.Binding
+	Decrease key.Binding
+	Increase key.Binding
 }
 
 func newKeyMap() keyMap {
@@ -17,15 +23,36 @@ func newKeyMap() keyMap {
 			key.WithKeys("f"),
 			key.WithHelp("f", "focus areas"),
 		),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "toggle"),
+		),
+		Decrease: key.NewBinding(
+			key.WithKeys("left", "h", "-"),
+			key.WithHelp("←/h", "shorter"),
+		),
+		Increase: key.NewBinding(
+			key.WithKeys("right", "l", "+", "="),
+			key.WithHelp("→/l", "longer"),
+		),
 	}
 }
 
 func (k keyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.FocusAreas, k.Back}
+	return []key.Binding{k.Toggle, k.FocusAreas, k.Back}
 }
 
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
+		{k.Up, k.Down, k.Toggle, k.Decrease, k.Increase},
 		{k.FocusAreas, k.Back},
 	}
 }
@@ -5,28 +5,40 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/mole-squad/soq-tui/pkg/api"
 	"github.com/mole-squad/soq-tui/pkg/common"
 	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
 	"github.com/mole-squad/soq-tui/pkg/styles"
 )
 
 type Model struct {
 	client *api.Client
 	logger *logger.Logger
+	store  *store.Store
 
-	keys keyMap
-	help help.Model
+	prefs      store.Prefs
+	cursor     int
+	zonePrefix string
+
+	keys    keyMap
+	help    help.Model
+	helpBar mouse.HelpBar
 
 	width int
 }
 
-func New(logger *logger.Logger, client *api.Client) common.AppView {
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
 	return Model{
-		client: client,
-		logger: logger,
-		help:   help.New(),
-		keys:   newKeyMap(),
+		client:     client,
+		logger:     logger,
+		store:      store,
+		zonePrefix: zone.NewPrefix(),
+		help:       help.New(),
+		helpBar:    mouse.NewHelpBar(),
+		keys:       newKeyMap(),
 	}
 }
 
@@ -41,15 +53,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		if keyMsg, ok := m.helpBar.Clicked(m.keys, msg); ok {
+			return m.onKeyMsg(keyMsg)
+		}
+
+		if idx, ok := m.clickedConfirmation(msg); ok {
+			return m.toggleConfirmation(idx)
+		}
+
+		if idx, direction, ok := m.clickedDuration(msg); ok {
+			return m.adjustDuration(idx, direction)
+		}
 	}
 
 	return m, nil
 }
 
 func (m Model) View() string {
-	sections := make([]string, 0)
+	sections := []string{m.renderConfirmations(), m.renderFocusTimer()}
 
-	helpContent := m.help.View(m.keys)
+	helpContent := m.helpBar.View(m.help, m.keys)
 
 	sections = append(sections, lipgloss.NewStyle().Width(m.width).Render(helpContent))
 
@@ -61,9 +86,20 @@ func (m Model) Blur() (tea.Model, tea.Cmd) {
 }
 
 func (m Model) Focus() (tea.Model, tea.Cmd) {
+	m.loadPrefs()
+
 	return m, nil
 }
 
+func (m *Model) loadPrefs() {
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	m.prefs = prefs
+}
+
 func (m Model) onWindowMsg(msg tea.WindowSizeMsg) (Model, tea.Cmd) {
 	m.width = msg.Width
 
@@ -79,6 +115,27 @@ func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 	case key.Matches(msg, m.keys.FocusAreas):
 		return m, common.AppStateCmd(common.AppStateFocusAreaList)
+
+	case key.Matches(msg, m.keys.Up):
+		m.cursor = max(0, m.cursor-1)
+
+	case key.Matches(msg, m.keys.Down):
+		m.cursor = min(len(confirmOptions)+len(durationOptions)-1, m.cursor+1)
+
+	case key.Matches(msg, m.keys.Toggle):
+		if m.cursor < len(confirmOptions) {
+			return m.toggleConfirmation(m.cursor)
+		}
+
+	case key.Matches(msg, m.keys.Decrease):
+		if idx, ok := m.durationIdx(); ok {
+			return m.adjustDuration(idx, -1)
+		}
+
+	case key.Matches(msg, m.keys.Increase):
+		if idx, ok := m.durationIdx(); ok {
+			return m.adjustDuration(idx, 1)
+		}
 	}
 
 	return m, nil
@@ -3,6 +3,7 @@ package sidepanelview
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/mole-squad/soq-tui/pkg/styles"
 )
 
@@ -15,6 +16,8 @@ var (
 type Model struct {
 	isPanelOpen bool
 
+	zonePrefix string
+
 	panelWidth int
 	height     int
 	width      int
@@ -26,6 +29,7 @@ func New(opts ...SidePanelViewOption) Model {
 	view := Model{
 		isPanelOpen: false,
 		panelWidth:  20,
+		zonePrefix:  zone.NewPrefix(),
 	}
 
 	for _, opt := range opts {
@@ -55,7 +59,7 @@ func (v Model) View() string {
 func (v Model) Render(mainPanelContent string, sidePanelContent string) string {
 	sectionFrameWidth, sectionFrameHeight := PanelStyle.GetFrameSize()
 
-	content := mainPanelContent
+	content := zone.Mark(v.mainZoneID(), mainPanelContent)
 
 	if v.isPanelOpen {
 		contentWidth := v.width - v.panelWidth
@@ -67,18 +71,36 @@ func (v Model) Render(mainPanelContent string, sidePanelContent string) string {
 
 		content = lipgloss.JoinHorizontal(
 			lipgloss.Top,
-			lipgloss.NewStyle().Width(contentWidth).Render(mainPanelContent),
-			wrappedSidePanelContent,
+			zone.Mark(v.mainZoneID(), lipgloss.NewStyle().Width(contentWidth).Render(mainPanelContent)),
+			zone.Mark(v.panelZoneID(), wrappedSidePanelContent),
 		)
 	}
 
 	return content
 }
 
+// InMain reports whether a mouse event landed on the main content.
+func (v Model) InMain(msg tea.MouseMsg) bool {
+	return zone.Get(v.mainZoneID()).InBounds(msg)
+}
+
+// InPanel reports whether a mouse event landed on the open side panel.
+func (v Model) InPanel(msg tea.MouseMsg) bool {
+	return v.isPanelOpen && zone.Get(v.panelZoneID()).InBounds(msg)
+}
+
 func (v *Model) SetIsOpen(isOpen bool) {
 	v.isPanelOpen = isOpen
 }
 
+func (v Model) IsOpen() bool {
+	return v.isPanelOpen
+}
+
+func (v *Model) SetPanelWidth(width int) {
+	v.panelWidth = width
+}
+
 func (v Model) GetContentWidth() int {
 	if v.isPanelOpen {
 		return v.width - v.panelWidth
@@ -96,6 +118,20 @@ func (v Model) GetPanelContentSize() (int, int) {
 	return contentWidth, contentHeight
 }
 
+func (v Model) mainZoneID() string {
+	return v.zonePrefix + "main"
+}
+
+func (v Model) panelZoneID() string {
+	return v.zonePrefix + "panel"
+}
+
+func WithPanelWidth(width int) SidePanelViewOption {
+	return func(v *Model) {
+		v.panelWidth = width
+	}
+}
+
 func (v Model) onWindowSize(width, height int) (Model, tea.Cmd) {
 	v.height = height
 	v.width = width
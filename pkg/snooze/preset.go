@@ -0,0 +1,51 @@
+package snooze
+
+import (
+	"time"
+
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+)
+
+// DayFormat shows the day a snooze ends.
+const DayFormat = "Mon Jan 2"
+
+// Preset is a snooze offered as a choice. Every preset ends at the start of a
+// day.
+type Preset int
+
+const (
+	Tomorrow Preset = iota
+	NextMonday
+	InTwoWeeks
+)
+
+// Presets lists the presets in the order they are offered.
+var Presets = []Preset{Tomorrow, NextMonday, InTwoWeeks}
+
+func (p Preset) String() string {
+	switch p {
+	case NextMonday:
+		return "Next Monday"
+	case InTwoWeeks:
+		return "In two weeks"
+	}
+
+	return "Tomorrow"
+}
+
+// Label names the preset with the day it ends, such as "Tomorrow · Thu Oct 22".
+func (p Preset) Label(now time.Time) string {
+	return p.String() + " · " + p.Until(now).Format(DayFormat)
+}
+
+// Until returns when a snooze started at now ends.
+func (p Preset) Until(now time.Time) time.Time {
+	switch p {
+	case NextMonday:
+		return timetracking.StartOfWeek(now).AddDate(0, 0, 7)
+	case InTwoWeeks:
+		return timetracking.StartOfDay(now).AddDate(0, 0, 14)
+	}
+
+	return timetracking.StartOfDay(now).AddDate(0, 0, 1)
+}
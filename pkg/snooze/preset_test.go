@@ -0,0 +1,50 @@
+package snooze
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPresetUntil(t *testing.T) {
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	wednesday := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	sunday := time.Date(2026, time.October, 25, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		preset Preset
+		now    time.Time
+		want   time.Time
+	}{
+		{"tomorrow", Tomorrow, wednesday, day(time.October, 22)},
+		{"tomorrow at the end of the month", Tomorrow, time.Date(2026, time.October, 31, 22, 0, 0, 0, time.UTC), day(time.November, 1)},
+		{"next monday from wednesday", NextMonday, wednesday, day(time.October, 26)},
+		{"next monday from monday", NextMonday, monday, day(time.October, 26)},
+		{"next monday from sunday", NextMonday, sunday, day(time.October, 26)},
+		{"in two weeks", InTwoWeeks, wednesday, day(time.November, 4)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.preset.Until(tt.now); !got.Equal(tt.want) {
+				t.Errorf("%s.Until(%s) = %s, want %s", tt.preset, tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPresetLabel(t *testing.T) {
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+
+	want := []string{"Tomorrow · Thu Oct 22", "Next Monday · Mon Oct 26", "In two weeks · Wed Nov 4"}
+
+	for idx, preset := range Presets {
+		if got := preset.Label(now); got != want[idx] {
+			t.Errorf("Label = %q, want %q", got, want[idx])
+		}
+	}
+}
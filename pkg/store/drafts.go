@@ -0,0 +1,66 @@
+package store
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+const draftsDir = "drafts"
+
+var unsafeDraftChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// Draft holds unsubmitted form values so they can be recovered after the app
+// exits unexpectedly.
+type Draft struct {
+	Values  map[string]any `json:"values"`
+	SavedAt time.Time      `json:"savedAt"`
+}
+
+func (s *Store) LoadDraft(formID, key string) (Draft, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var draft Draft
+
+	found, err := s.readJSON(draftFileName(formID, key), &draft)
+	if err != nil {
+		return draft, false, fmt.Errorf("error loading draft: %w", err)
+	}
+
+	return draft, found, nil
+}
+
+func (s *Store) SaveDraft(formID, key string, values map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	draft := Draft{
+		Values:  values,
+		SavedAt: time.Now(),
+	}
+
+	if err := s.writeJSON(draftFileName(formID, key), draft); err != nil {
+		return fmt.Errorf("error saving draft: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) DeleteDraft(formID, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.remove(draftFileName(formID, key)); err != nil {
+		return fmt.Errorf("error deleting draft: %w", err)
+	}
+
+	return nil
+}
+
+func draftFileName(formID, key string) string {
+	name := unsafeDraftChars.ReplaceAllString(formID+"-"+key, "_")
+
+	return filepath.Join(draftsDir, name+".json")
+}
@@ -0,0 +1,69 @@
+package store
+
+import "fmt"
+
+const focusAreaMetaFile = "focusareas.json"
+
+// FocusAreaMeta holds how a focus area is displayed. The API only stores the
+// name, so these are kept locally.
+type FocusAreaMeta struct {
+	Color string `json:"color,omitempty"`
+	Glyph string `json:"glyph,omitempty"`
+}
+
+func (m FocusAreaMeta) IsZero() bool {
+	return m.Color == "" && m.Glyph == ""
+}
+
+func (s *Store) LoadFocusAreaMeta() (map[uint]FocusAreaMeta, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readFocusAreaMeta()
+}
+
+// SaveFocusAreaMeta stores the display settings of a focus area, removing the
+// entry when meta is empty.
+func (s *Store) SaveFocusAreaMeta(focusAreaID uint, meta FocusAreaMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metas, err := s.readFocusAreaMeta()
+	if err != nil {
+		return err
+	}
+
+	if meta.IsZero() {
+		if _, ok := metas[focusAreaID]; !ok {
+			return nil
+		}
+
+		delete(metas, focusAreaID)
+	} else {
+		metas[focusAreaID] = meta
+	}
+
+	return s.writeFocusAreaMeta(metas)
+}
+
+func (s *Store) DeleteFocusAreaMeta(focusAreaID uint) error {
+	return s.SaveFocusAreaMeta(focusAreaID, FocusAreaMeta{})
+}
+
+func (s *Store) readFocusAreaMeta() (map[uint]FocusAreaMeta, error) {
+	metas := make(map[uint]FocusAreaMeta)
+
+	if _, err := s.readJSON(focusAreaMetaFile, &metas); err != nil {
+		return nil, fmt.Errorf("error loading focus area metadata: %w", err)
+	}
+
+	return metas, nil
+}
+
+func (s *Store) writeFocusAreaMeta(metas map[uint]FocusAreaMeta) error {
+	if err := s.writeJSON(focusAreaMetaFile, metas); err != nil {
+		return fmt.Errorf("error saving focus area metadata: %w", err)
+	}
+
+	return nil
+}
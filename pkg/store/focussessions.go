@@ -0,0 +1,60 @@
+package store
+
+import (
+	"fmt"
+	"time"
+)
+
+const focusSessionsFile = "focus.json"
+
+// FocusSession is a completed work period of the focus timer.
+type FocusSession struct {
+	TaskID    uint      `json:"taskId"`
+	Summary   string    `json:"summary"`
+	StartedAt time.Time `json:"startedAt"`
+	EndedAt   time.Time `json:"endedAt"`
+}
+
+func (s *Store) LoadFocusSessions() ([]FocusSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readFocusSessions()
+}
+
+func (s *Store) RecordFocusSession(session FocusSession) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions, err := s.readFocusSessions()
+	if err != nil {
+		return err
+	}
+
+	if err := s.writeJSON(focusSessionsFile, append(sessions, session)); err != nil {
+		return fmt.Errorf("error saving focus sessions: %w", err)
+	}
+
+	return nil
+}
+
+// CountFocusSessions returns the number of completed sessions for each task.
+func CountFocusSessions(sessions []FocusSession) map[uint]int {
+	counts := make(map[uint]int)
+
+	for _, session := range sessions {
+		counts[session.TaskID]++
+	}
+
+	return counts
+}
+
+func (s *Store) readFocusSessions() ([]FocusSession, error) {
+	sessions := make([]FocusSession, 0)
+
+	if _, err := s.readJSON(focusSessionsFile, &sessions); err != nil {
+		return nil, fmt.Errorf("error loading focus sessions: %w", err)
+	}
+
+	return sessions, nil
+}
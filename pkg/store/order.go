@@ -0,0 +1,31 @@
+package store
+
+import "fmt"
+
+const taskOrderFile = "order.json"
+
+// LoadTaskOrder returns the task IDs in the order the user arranged them.
+// Tasks that were never arranged are not listed.
+func (s *Store) LoadTaskOrder() ([]uint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order := make([]uint, 0)
+
+	if _, err := s.readJSON(taskOrderFile, &order); err != nil {
+		return nil, fmt.Errorf("error loading task order: %w", err)
+	}
+
+	return order, nil
+}
+
+func (s *Store) SaveTaskOrder(order []uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.writeJSON(taskOrderFile, order); err != nil {
+		return fmt.Errorf("error saving task order: %w", err)
+	}
+
+	return nil
+}
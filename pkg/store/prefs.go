@@ -0,0 +1,114 @@
+package store
+
+import (
+	"fmt"
+	"time"
+)
+
+const prefsFile = "prefs.json"
+
+// Focus timer lengths used until changed in the settings.
+const (
+	DefaultFocusWorkMinutes  = 25
+	DefaultFocusBreakMinutes = 5
+)
+
+// Actions that ask for confirmation unless turned off in the settings.
+const (
+	ConfirmDeleteTask      = "deleteTask"
+	ConfirmResolveTask     = "resolveTask"
+	ConfirmDeleteFocusArea = "deleteFocusArea"
+)
+
+// Prefs holds UI choices that are restored between sessions.
+type Prefs struct {
+	TaskListFocusAreaID uint   `json:"taskListFocusAreaId"`
+	GroupByFocusArea    bool   `json:"groupByFocusArea"`
+	TaskListSort        string `json:"taskListSort"`
+	TaskListDescending  bool   `json:"taskListDescending"`
+	TaskListQuery       string `json:"taskListQuery"`
+	TaskListView        string `json:"taskListView"`
+	HideSubtasks        bool   `json:"hideSubtasks"`
+	ShowSnoozed         bool   `json:"showSnoozed"`
+
+	Confirmations map[string]bool `json:"confirmations,omitempty"`
+
+	FocusWorkMinutes  int `json:"focusWorkMinutes,omitempty"`
+	FocusBreakMinutes int `json:"focusBreakMinutes,omitempty"`
+
+	// FocusNotifyCommand is run through the shell when a focus timer phase
+	// ends, with SOQ_TASK and SOQ_PHASE set.
+	FocusNotifyCommand string `json:"focusNotifyCommand,omitempty"`
+
+	// ReminderCommand is run through the shell when a task comes due, with
+	// SOQ_TASK, SOQ_TASK_ID and SOQ_DUE set.
+	ReminderCommand string `json:"reminderCommand,omitempty"`
+}
+
+func (p Prefs) WorkMinutes() int {
+	if p.FocusWorkMinutes <= 0 {
+		return DefaultFocusWorkMinutes
+	}
+
+	return p.FocusWorkMinutes
+}
+
+func (p Prefs) BreakMinutes() int {
+	if p.FocusBreakMinutes <= 0 {
+		return DefaultFocusBreakMinutes
+	}
+
+	return p.FocusBreakMinutes
+}
+
+func (p Prefs) WorkDuration() time.Duration {
+	return time.Duration(p.WorkMinutes()) * time.Minute
+}
+
+func (p Prefs) BreakDuration() time.Duration {
+	return time.Duration(p.BreakMinutes()) * time.Minute
+}
+
+// ShouldConfirm reports whether an action asks for confirmation. Actions ask
+// by default.
+func (p Prefs) ShouldConfirm(action string) bool {
+	confirm, ok := p.Confirmations[action]
+
+	return !ok || confirm
+}
+
+func (s *Store) LoadPrefs() (Prefs, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readPrefs()
+}
+
+// UpdatePrefs applies update to the saved preferences and saves them.
+func (s *Store) UpdatePrefs(update func(prefs *Prefs)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefs, err := s.readPrefs()
+	if err != nil {
+		return err
+	}
+
+	update(&prefs)
+
+	if err := s.writeJSON(prefsFile, prefs); err != nil {
+		return fmt.Errorf("error saving preferences: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) readPrefs() (Prefs, error) {
+	var prefs Prefs
+
+	if _, err := s.readJSON(prefsFile, &prefs); err != nil {
+		return prefs, fmt.Errorf("error loading preferences: %w", err)
+	}
+
+	return prefs, nil
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+)
+
+const (
+	resolvedFile = "resolved.json"
+
+	// maxResolvedTasks caps the history; the oldest entries are dropped first.
+	maxResolvedTasks = 500
+)
+
+// ResolvedTask is a task as it was when it was resolved. The API only lists
+// open tasks, so resolved ones are kept locally.
+type ResolvedTask struct {
+	Task       soqapi.TaskDTO `json:"task"`
+	ResolvedAt time.Time      `json:"resolvedAt"`
+
+	// NextID is the task created as the next instance of a repeating task.
+	NextID uint `json:"nextId,omitempty"`
+}
+
+// LoadResolvedTasks returns the resolution history, newest first.
+func (s *Store) LoadResolvedTasks() ([]ResolvedTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readResolvedTasks()
+}
+
+// RecordResolvedTask adds a task to the front of the resolution history.
+func (s *Store) RecordResolvedTask(resolved ResolvedTask) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history, err := s.readResolvedTasks()
+	if err != nil {
+		return err
+	}
+
+	updated := make([]ResolvedTask, 0, len(history)+1)
+	updated = append(updated, resolved)
+
+	for _, entry := range history {
+		if entry.Task.ID != resolved.Task.ID {
+			updated = append(updated, entry)
+		}
+	}
+
+	if len(updated) > maxResolvedTasks {
+		updated = updated[:maxResolvedTasks]
+	}
+
+	return s.writeResolvedTasks(updated)
+}
+
+// ForgetResolvedTask removes a task from the resolution history.
+func (s *Store) ForgetResolvedTask(taskID uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history, err := s.readResolvedTasks()
+	if err != nil {
+		return err
+	}
+
+	updated := make([]ResolvedTask, 0, len(history))
+	for _, resolved := range history {
+		if resolved.Task.ID != taskID {
+			updated = append(updated, resolved)
+		}
+	}
+
+	if len(updated) == len(history) {
+		return nil
+	}
+
+	return s.writeResolvedTasks(updated)
+}
+
+func (s *Store) readResolvedTasks() ([]ResolvedTask, error) {
+	history := make([]ResolvedTask, 0)
+
+	if _, err := s.readJSON(resolvedFile, &history); err != nil {
+		return nil, fmt.Errorf("error loading resolved tasks: %w", err)
+	}
+
+	return history, nil
+}
+
+func (s *Store) writeResolvedTasks(history []ResolvedTask) error {
+	if err := s.writeJSON(resolvedFile, history); err != nil {
+		return fmt.Errorf("error saving resolved tasks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const reviewFile = "review.json"
+
+// Review records the last finished task review. The API does not track when
+// tasks are added, so the review keeps the IDs of the tasks open when it
+// started; any other task was added since.
+type Review struct {
+	At      time.Time `json:"at"`
+	TaskIDs []uint    `json:"taskIds"`
+}
+
+// NeedsReview reports whether a task was added or changed since the review,
+// or came back from a snooze since. Every task needs a review before the
+// first one.
+func (r Review) NeedsReview(taskID uint, meta TaskMeta) bool {
+	if r.At.IsZero() || !slices.Contains(r.TaskIDs, taskID) {
+		return true
+	}
+
+	if meta.CreatedAt.After(r.At) || meta.UpdatedAt.After(r.At) {
+		return true
+	}
+
+	return meta.SnoozedUntil != nil && meta.SnoozedUntil.After(r.At)
+}
+
+// LoadReview returns the last review, or the zero Review if there was none.
+func (s *Store) LoadReview() (Review, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var review Review
+
+	if _, err := s.readJSON(reviewFile, &review); err != nil {
+		return review, fmt.Errorf("error loading review: %w", err)
+	}
+
+	return review, nil
+}
+
+func (s *Store) SaveReview(review Review) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.writeJSON(reviewFile, review); err != nil {
+		return fmt.Errorf("error saving review: %w", err)
+	}
+
+	return nil
+}
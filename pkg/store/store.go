@@ -0,0 +1,155 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	dirPerm  = 0700
+	filePerm = 0600
+)
+
+// Store persists local app state as JSON files in the config directory.
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func New(dir string) *Store {
+	return &Store{
+		dir: dir,
+	}
+}
+
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+func (s *Store) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
+
+// readJSON decodes the named file into v. It reports false without an error if
+// the file does not exist.
+func (s *Store) readJSON(name string, v any) (bool, error) {
+	data, err := os.ReadFile(s.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error reading %s: %w", name, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(v); err != nil {
+		return false, fmt.Errorf("error decoding %s: %w", name, err)
+	}
+
+	return true, nil
+}
+
+// writeJSON replaces the named file with the JSON encoding of v. The file is
+// written to a temporary path first so a crash never leaves it truncated.
+func (s *Store) writeJSON(name string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding %s: %w", name, err)
+	}
+
+	filePath := s.path(name)
+
+	if err := os.MkdirAll(filepath.Dir(filePath), dirPerm); err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", name, err)
+	}
+
+	tmpPath := filePath + ".tmp"
+
+	if err := os.WriteFile(tmpPath, data, filePerm); err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		return fmt.Errorf("error replacing %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (s *Store) remove(name string) error {
+	err := os.Remove(s.path(name))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// appendJSONLine adds the JSON encoding of v to the named file as one line.
+// The line is written with a single call so concurrent writers do not
+// interleave.
+func (s *Store) appendJSONLine(name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error encoding %s: %w", name, err)
+	}
+
+	filePath := s.path(name)
+
+	if err := os.MkdirAll(filepath.Dir(filePath), dirPerm); err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", name, err)
+	}
+
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePerm)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", name, err)
+	}
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// readJSONLines decodes each line of the named file with decode. A missing
+// file has no lines. An unterminated last line is left over from an
+// interrupted write and is skipped.
+func (s *Store) readJSONLines(name string, decode func(line []byte) error) error {
+	data, err := os.ReadFile(s.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error reading %s: %w", name, err)
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+
+	// The last element follows the final newline, so it is either empty or
+	// unterminated.
+	for idx, line := range lines[:len(lines)-1] {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if err := decode(line); err != nil {
+			return fmt.Errorf("error decoding %s line %d: %w", name, idx+1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package store
+
+import "github.com/mole-squad/soq-tui/pkg/tags"
+
+// TagCounts returns every tag in the task metadata with the number of tasks
+// carrying it.
+func (s *Store) TagCounts() (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metas, err := s.readTaskMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+
+	for _, meta := range metas {
+		for _, tag := range meta.Tags {
+			counts[tag]++
+		}
+	}
+
+	return counts, nil
+}
+
+// RenameTags replaces the tags in from with to on every task, which merges
+// them when there is more than one. It returns the number of tasks changed.
+func (s *Store) RenameTags(from []string, to string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metas, err := s.readTaskMeta()
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+
+	for taskID, meta := range metas {
+		replaced, ok := tags.Replace(meta.Tags, from, to)
+		if !ok {
+			continue
+		}
+
+		meta.Tags = replaced
+		metas[taskID] = meta
+		changed++
+	}
+
+	if changed == 0 {
+		return 0, nil
+	}
+
+	return changed, s.writeTaskMeta(metas)
+}
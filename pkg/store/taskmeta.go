@@ -0,0 +1,184 @@
+package store
+
+import (
+	"fmt"
+	"time"
+)
+
+const taskMetaFile = "tasks.json"
+
+// Priority ranks how urgent a task is. The zero value means no priority.
+type Priority int
+
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+)
+
+// Priorities lists the priority levels from lowest to highest.
+var Priorities = []Priority{PriorityNone, PriorityLow, PriorityMedium, PriorityHigh}
+
+// Label names the priority for display. It is not a String method so form
+// values compare by number, the way they are saved in drafts.
+func (p Priority) Label() string {
+	switch p {
+	case PriorityLow:
+		return "Low"
+	case PriorityMedium:
+		return "Medium"
+	case PriorityHigh:
+		return "High"
+	}
+
+	return "None"
+}
+
+// DueDate is when a task is due. A task due on a day without a time is due
+// by the end of that day.
+type DueDate struct {
+	At      time.Time `json:"at"`
+	HasTime bool      `json:"hasTime,omitempty"`
+
+	// Reminded is set once a reminder was shown for this due date.
+	Reminded bool `json:"reminded,omitempty"`
+}
+
+// Deadline returns the moment the task becomes overdue.
+func (d DueDate) Deadline() time.Time {
+	if d.HasTime {
+		return d.At
+	}
+
+	year, month, day := d.At.Date()
+
+	return time.Date(year, month, day+1, 0, 0, 0, 0, d.At.Location())
+}
+
+// RemindAt returns when the task comes due: at its time, or at the start of
+// its day.
+func (d DueDate) RemindAt() time.Time {
+	if d.HasTime {
+		return d.At
+	}
+
+	year, month, day := d.At.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, d.At.Location())
+}
+
+// SameAs reports whether two due dates are for the same moment.
+func (d DueDate) SameAs(other DueDate) bool {
+	return d.At.Equal(other.At) && d.HasTime == other.HasTime
+}
+
+// TaskMeta holds details about a task that the API does not track.
+type TaskMeta struct {
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Priority  Priority  `json:"priority,omitempty"`
+	Due       *DueDate  `json:"due,omitempty"`
+
+	// Recurrence is the rule the task repeats by, as read by
+	// recurrence.Parse. Resolving a repeating task creates its next instance.
+	Recurrence string `json:"recurrence,omitempty"`
+
+	// Tags label the task across focus areas. They are normalized by
+	// tags.Normalize.
+	Tags []string `json:"tags,omitempty"`
+
+	// SnoozedUntil hides the task from the task list until then.
+	SnoozedUntil *time.Time `json:"snoozedUntil,omitempty"`
+}
+
+// IsSnoozed reports whether the task is hidden by a snooze at now.
+func (m TaskMeta) IsSnoozed(now time.Time) bool {
+	return m.SnoozedUntil != nil && now.Before(*m.SnoozedUntil)
+}
+
+// IsWoken reports whether the snooze of the task ended earlier on the day of
+// now, so it can be pointed out when it comes back.
+func (m TaskMeta) IsWoken(now time.Time) bool {
+	if m.SnoozedUntil == nil || m.IsSnoozed(now) {
+		return false
+	}
+
+	year, month, day := m.SnoozedUntil.Date()
+	nowYear, nowMonth, nowDay := now.In(m.SnoozedUntil.Location()).Date()
+
+	return year == nowYear && month == nowMonth && day == nowDay
+}
+
+func (s *Store) LoadTaskMeta() (map[uint]TaskMeta, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readTaskMeta()
+}
+
+// UpdateTaskMeta applies update to the metadata of a single task and saves it.
+func (s *Store) UpdateTaskMeta(taskID uint, update func(meta *TaskMeta)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metas, err := s.readTaskMeta()
+	if err != nil {
+		return err
+	}
+
+	meta := metas[taskID]
+	update(&meta)
+	metas[taskID] = meta
+
+	return s.writeTaskMeta(metas)
+}
+
+// TouchTask records that a task was saved, and when it was created if isNew.
+func (s *Store) TouchTask(taskID uint, isNew bool) error {
+	now := time.Now()
+
+	return s.UpdateTaskMeta(taskID, func(meta *TaskMeta) {
+		if isNew {
+			meta.CreatedAt = now
+		}
+
+		meta.UpdatedAt = now
+	})
+}
+
+func (s *Store) DeleteTaskMeta(taskID uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metas, err := s.readTaskMeta()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := metas[taskID]; !ok {
+		return nil
+	}
+
+	delete(metas, taskID)
+
+	return s.writeTaskMeta(metas)
+}
+
+func (s *Store) readTaskMeta() (map[uint]TaskMeta, error) {
+	metas := make(map[uint]TaskMeta)
+
+	if _, err := s.readJSON(taskMetaFile, &metas); err != nil {
+		return nil, fmt.Errorf("error loading task metadata: %w", err)
+	}
+
+	return metas, nil
+}
+
+func (s *Store) writeTaskMeta(metas map[uint]TaskMeta) error {
+	if err := s.writeJSON(taskMetaFile, metas); err != nil {
+		return fmt.Errorf("error saving task metadata: %w", err)
+	}
+
+	return nil
+}
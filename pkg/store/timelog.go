@@ -0,0 +1,227 @@
+package store
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+)
+
+const timeLogFile = "timelog.jsonl"
+
+// Operations recorded in the time log.
+const (
+	timeLogStart  = "start"
+	timeLogStop   = "stop"
+	timeLogEdit   = "edit"
+	timeLogDelete = "delete"
+)
+
+// TimeEntry is time tracked against a task. The task summary and focus area
+// are copied when tracking starts, so reports do not depend on the API. End is
+// zero while the timer runs.
+type TimeEntry struct {
+	ID          uint      `json:"id"`
+	TaskID      uint      `json:"taskId"`
+	Summary     string    `json:"summary"`
+	FocusAreaID uint      `json:"focusAreaId"`
+	FocusArea   string    `json:"focusArea"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+}
+
+func (e TimeEntry) IsRunning() bool {
+	return e.End.IsZero()
+}
+
+// Duration returns the tracked time, counting a running entry up to now.
+func (e TimeEntry) Duration(now time.Time) time.Duration {
+	if e.IsRunning() {
+		return now.Sub(e.Start)
+	}
+
+	return e.End.Sub(e.Start)
+}
+
+// timeLogRecord is a line of the time log. The log is only ever appended to;
+// edits and deletions are recorded as new lines and applied on load.
+type timeLogRecord struct {
+	Op    string    `json:"op"`
+	At    time.Time `json:"at"`
+	Entry TimeEntry `json:"entry"`
+}
+
+// LoadTimeEntries returns the tracked time, oldest first.
+func (s *Store) LoadTimeEntries() ([]TimeEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readTimeEntries()
+}
+
+// ActiveTimeEntry returns the running entry, if any.
+func ActiveTimeEntry(entries []TimeEntry) (TimeEntry, bool) {
+	for idx := len(entries) - 1; idx >= 0; idx-- {
+		if entries[idx].IsRunning() {
+			return entries[idx], true
+		}
+	}
+
+	return TimeEntry{}, false
+}
+
+// TrackedByTask returns the total tracked time of each task.
+func TrackedByTask(entries []TimeEntry, now time.Time) map[uint]time.Duration {
+	totals := make(map[uint]time.Duration)
+
+	for _, entry := range entries {
+		totals[entry.TaskID] += entry.Duration(now)
+	}
+
+	return totals
+}
+
+// StartTimeEntry starts tracking time on a task. Only one timer runs at a
+// time, so a running entry is stopped first.
+func (s *Store) StartTimeEntry(task soqapi.TaskDTO, at time.Time) (TimeEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, lastID, err := s.readTimeLog()
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	if err := s.stopRunning(entries, at); err != nil {
+		return TimeEntry{}, err
+	}
+
+	entry := TimeEntry{
+		ID:          lastID + 1,
+		TaskID:      task.ID,
+		Summary:     task.Summary,
+		FocusAreaID: task.FocusArea.ID,
+		FocusArea:   task.FocusArea.Name,
+		Start:       at,
+	}
+
+	return entry, s.appendTimeLog(timeLogStart, at, entry)
+}
+
+// StopTimeEntry stops the running entry. It reports false if no timer was
+// running.
+func (s *Store) StopTimeEntry(at time.Time) (TimeEntry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.readTimeEntries()
+	if err != nil {
+		return TimeEntry{}, false, err
+	}
+
+	entry, ok := ActiveTimeEntry(entries)
+	if !ok {
+		return TimeEntry{}, false, nil
+	}
+
+	entry.End = at
+
+	return entry, true, s.stopRunning(entries, at)
+}
+
+// UpdateTimeEntry replaces an entry, or restores it if it was deleted.
+func (s *Store) UpdateTimeEntry(entry TimeEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.appendTimeLog(timeLogEdit, time.Now(), entry)
+}
+
+func (s *Store) DeleteTimeEntry(entryID uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.appendTimeLog(timeLogDelete, time.Now(), TimeEntry{ID: entryID})
+}
+
+func (s *Store) stopRunning(entries []TimeEntry, at time.Time) error {
+	for _, entry := range entries {
+		if !entry.IsRunning() {
+			continue
+		}
+
+		entry.End = at
+		if at.Before(entry.Start) {
+			entry.End = entry.Start
+		}
+		if err := s.appendTimeLog(timeLogStop, at, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) readTimeEntries() ([]TimeEntry, error) {
+	entries, _, err := s.readTimeLog()
+
+	return entries, err
+}
+
+// readTimeLog applies the time log and returns the entries, along with the
+// highest ID any record used. Deleted entries count too, so their IDs are not
+// handed out again and undoing the delete cannot replace a newer entry.
+func (s *Store) readTimeLog() ([]TimeEntry, uint, error) {
+	byID := make(map[uint]TimeEntry)
+
+	var lastID uint
+
+	err := s.readJSONLines(timeLogFile, func(line []byte) error {
+		var record timeLogRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return err
+		}
+
+		lastID = max(lastID, record.Entry.ID)
+
+		switch record.Op {
+		case timeLogStart, timeLogStop, timeLogEdit:
+			byID[record.Entry.ID] = record.Entry
+		case timeLogDelete:
+			delete(byID, record.Entry.ID)
+		default:
+			return fmt.Errorf("unknown operation %q", record.Op)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error loading time log: %w", err)
+	}
+
+	entries := make([]TimeEntry, 0, len(byID))
+	for _, entry := range byID {
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b TimeEntry) int {
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return entries, lastID, nil
+}
+
+func (s *Store) appendTimeLog(op string, at time.Time, entry TimeEntry) error {
+	if err := s.appendJSONLine(timeLogFile, timeLogRecord{Op: op, At: at, Entry: entry}); err != nil {
+		return fmt.Errorf("error saving time log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+)
+
+func TestStartTimeEntryAfterDelete(t *testing.T) {
+	s := New(t.TempDir())
+	start := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+
+	first, err := s.StartTimeEntry(soqapi.TaskDTO{ID: 1, Summary: "First"}, start)
+	if err != nil {
+		t.Fatalf("StartTimeEntry returned error: %v", err)
+	}
+
+	deleted, err := s.StartTimeEntry(soqapi.TaskDTO{ID: 2, Summary: "Deleted"}, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("StartTimeEntry returned error: %v", err)
+	}
+
+	entries, err := s.LoadTimeEntries()
+	if err != nil {
+		t.Fatalf("LoadTimeEntries returned error: %v", err)
+	}
+
+	// Keep the stopped entry, as deleting it is what gets undone below.
+	deleted = entries[len(entries)-1]
+
+	if err := s.DeleteTimeEntry(deleted.ID); err != nil {
+		t.Fatalf("DeleteTimeEntry returned error: %v", err)
+	}
+
+	next, err := s.StartTimeEntry(soqapi.TaskDTO{ID: 3, Summary: "Next"}, start.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("StartTimeEntry returned error: %v", err)
+	}
+
+	if next.ID == first.ID || next.ID == deleted.ID {
+		t.Fatalf("new entry got ID %d, already used by entries %d and %d", next.ID, first.ID, deleted.ID)
+	}
+
+	// Undoing the delete restores the entry without replacing the new one.
+	if err := s.UpdateTimeEntry(deleted); err != nil {
+		t.Fatalf("UpdateTimeEntry returned error: %v", err)
+	}
+
+	entries, err = s.LoadTimeEntries()
+	if err != nil {
+		t.Fatalf("LoadTimeEntries returned error: %v", err)
+	}
+
+	summaries := make([]string, len(entries))
+	for idx, entry := range entries {
+		summaries[idx] = entry.Summary
+	}
+
+	if len(entries) != 3 || summaries[0] != "First" || summaries[1] != "Deleted" || summaries[2] != "Next" {
+		t.Errorf("entries after undo = %q, want First, Deleted and Next", summaries)
+	}
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+)
+
+const viewsFile = "views.json"
+
+// SavedView is a named task list filter, search, sort and grouping. The focus
+// area is stored by name so the file can be edited by hand; an empty focus area
+// matches every task.
+type SavedView struct {
+	Name       string `json:"name"`
+	FocusArea  string `json:"focusArea,omitempty"`
+	Query      string `json:"query,omitempty"`
+	Sort       string `json:"sort,omitempty"`
+	Descending bool   `json:"descending,omitempty"`
+	Group      bool   `json:"group,omitempty"`
+}
+
+func (s *Store) LoadViews() ([]SavedView, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readViews()
+}
+
+// SaveView adds a view, replacing any view with the same name.
+func (s *Store) SaveView(view SavedView) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	views, err := s.readViews()
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+
+	for idx, existing := range views {
+		if strings.EqualFold(existing.Name, view.Name) {
+			views[idx] = view
+			replaced = true
+		}
+	}
+
+	if !replaced {
+		views = append(views, view)
+	}
+
+	if err := s.writeJSON(viewsFile, views); err != nil {
+		return fmt.Errorf("error saving views: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) readViews() ([]SavedView, error) {
+	views := make([]SavedView, 0)
+
+	if _, err := s.readJSON(viewsFile, &views); err != nil {
+		return nil, fmt.Errorf("error loading views: %w", err)
+	}
+
+	return views, nil
+}
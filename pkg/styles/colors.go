@@ -5,4 +5,6 @@ import "github.com/charmbracelet/lipgloss"
 const (
 	HotPink  = lipgloss.Color("#FF06B7")
 	DarkGray = lipgloss.Color("#767676")
+	ErrorRed = lipgloss.Color("#FF5F5F")
+	Amber    = lipgloss.Color("#FFAF00")
 )
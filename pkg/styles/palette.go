@@ -0,0 +1,60 @@
+package styles
+
+import (
+	"hash/fnv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PaletteColor is a named color that can be assigned to a focus area.
+type PaletteColor struct {
+	Name  string
+	Color lipgloss.Color
+}
+
+var Palette = []PaletteColor{
+	{Name: "Pink", Color: HotPink},
+	{Name: "Red", Color: lipgloss.Color("#FF5F5F")},
+	{Name: "Orange", Color: lipgloss.Color("#FF8700")},
+	{Name: "Amber", Color: Amber},
+	{Name: "Green", Color: lipgloss.Color("#5FD75F")},
+	{Name: "Teal", Color: lipgloss.Color("#00D7AF")},
+	{Name: "Blue", Color: lipgloss.Color("#5F87FF")},
+	{Name: "Purple", Color: lipgloss.Color("#AF87FF")},
+	{Name: "Gray", Color: DarkGray},
+}
+
+// FocusAreaBadge renders a focus area name with its glyph, in its color if it
+// has one. Areas with a color but no glyph get a dot.
+func FocusAreaBadge(name, glyph, color string) string {
+	if glyph == "" && color != "" {
+		glyph = "●"
+	}
+
+	label := name
+	if glyph != "" {
+		label = strings.TrimSpace(glyph + " " + name)
+	}
+
+	if color == "" {
+		return label
+	}
+
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(label)
+}
+
+// TagChip renders a tag on a background picked from the palette by its name,
+// so a tag keeps its color everywhere.
+func TagChip(tag string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(tag))
+
+	// Gray, the last color, is left out as it reads as disabled.
+	color := Palette[hash.Sum32()%uint32(len(Palette)-1)].Color
+
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#000000")).
+		Background(color).
+		Render(" " + tag + " ")
+}
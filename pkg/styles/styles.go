@@ -12,6 +12,15 @@ var (
 			Border(lipgloss.RoundedBorder()).
 			BorderForeground(HotPink)
 
+	InputHelpStyle = lipgloss.NewStyle().
+			Foreground(DarkGray)
+
+	InputErrorStyle = lipgloss.NewStyle().
+			Foreground(ErrorRed)
+
+	DirtyMarkerStyle = lipgloss.NewStyle().
+				Foreground(Amber)
+
 	FormFieldWrapperStyle = lipgloss.NewStyle().Padding(0).Margin(0)
 
 	BorderStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(HotPink)
@@ -0,0 +1,139 @@
+package subtasks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mole-squad/soq-tui/pkg/markdown"
+)
+
+// The API has no subtasks, so they are kept in a block at the end of the task
+// notes. Each subtask is a Markdown checklist item between the two markers:
+//
+//	<!-- subtasks -->
+//	- [x] Draft outline
+//	- [ ] Review with team
+//	<!-- /subtasks -->
+//
+// The markers are HTML comments so the block reads as a plain checklist in
+// other clients.
+const (
+	blockStart = "<!-- subtasks -->"
+	blockEnd   = "<!-- /subtasks -->"
+)
+
+// Subtask is a single step of a task.
+type Subtask struct {
+	Text string
+	Done bool
+}
+
+// Parse returns the subtasks in the block of notes, or nil without one.
+func Parse(notes string) []Subtask {
+	_, items := Split(notes)
+
+	return items
+}
+
+// Split separates the subtasks block from the rest of notes. An unterminated
+// block runs to the end of the notes.
+func Split(notes string) (string, []Subtask) {
+	lines := strings.Split(notes, "\n")
+
+	start := -1
+	for idx, line := range lines {
+		if strings.TrimSpace(line) == blockStart {
+			start = idx
+			break
+		}
+	}
+
+	if start < 0 {
+		return notes, nil
+	}
+
+	end := len(lines)
+	for idx := start + 1; idx < len(lines); idx++ {
+		if strings.TrimSpace(lines[idx]) == blockEnd {
+			end = idx
+			break
+		}
+	}
+
+	items := ParseChecklist(strings.Join(lines[start+1:end], "\n"))
+
+	rest := append(lines[:start:start], lines[min(end+1, len(lines)):]...)
+	body := strings.TrimRight(strings.Join(rest, "\n"), "\n")
+
+	return body, items
+}
+
+// Join returns notes with the subtasks block replaced by items. Without
+// items the block is removed.
+func Join(notes string, items []Subtask) string {
+	body, _ := Split(notes)
+
+	if len(items) == 0 {
+		return body
+	}
+
+	block := blockStart + "\n" + FormatChecklist(items) + "\n" + blockEnd
+	if body == "" {
+		return block
+	}
+
+	return body + "\n\n" + block
+}
+
+// Reset returns notes with every subtask marked as not done.
+func Reset(notes string) string {
+	items := Parse(notes)
+	for idx := range items {
+		items[idx].Done = false
+	}
+
+	return Join(notes, items)
+}
+
+// FormatChecklist renders subtasks as Markdown checklist lines.
+func FormatChecklist(items []Subtask) string {
+	lines := make([]string, len(items))
+
+	for idx, item := range items {
+		mark := " "
+		if item.Done {
+			mark = "x"
+		}
+
+		lines[idx] = fmt.Sprintf("- [%s] %s", mark, item.Text)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ParseChecklist reads Markdown checklist lines into subtasks. Other lines
+// and items without text are skipped.
+func ParseChecklist(source string) []Subtask {
+	var items []Subtask
+
+	for _, item := range markdown.ParseChecklist(source) {
+		if item.Text != "" {
+			items = append(items, Subtask{Text: item.Text, Done: item.Checked})
+		}
+	}
+
+	return items
+}
+
+// Progress counts the done subtasks and all subtasks.
+func Progress(items []Subtask) (int, int) {
+	done := 0
+
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+
+	return done, len(items)
+}
@@ -0,0 +1,55 @@
+package tags
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Normalize turns user input into a tag name: lowercase, without a leading
+// "#", and with runs of spaces replaced by a dash, so "#Waiting On" becomes
+// "waiting-on". It returns "" for input without a name.
+func Normalize(raw string) string {
+	name := strings.TrimLeft(strings.TrimSpace(raw), "#")
+
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), unicode.IsSpace), "-")
+}
+
+// Parse reads a list of tags separated by commas, such as "blocked, quick".
+// Tags are normalized and duplicates dropped, keeping the first of each.
+func Parse(list string) []string {
+	var parsed []string
+
+	for _, raw := range strings.Split(list, ",") {
+		if tag := Normalize(raw); tag != "" && !slices.Contains(parsed, tag) {
+			parsed = append(parsed, tag)
+		}
+	}
+
+	return parsed
+}
+
+// Format renders tags the way Parse reads them.
+func Format(tags []string) string {
+	return strings.Join(tags, ", ")
+}
+
+// Replace returns tags with every tag in from replaced by to, keeping its
+// position and dropping duplicates. It reports whether anything changed.
+func Replace(tags []string, from []string, to string) ([]string, bool) {
+	replaced := make([]string, 0, len(tags))
+	changed := false
+
+	for _, tag := range tags {
+		if slices.Contains(from, tag) {
+			tag = to
+			changed = true
+		}
+
+		if !slices.Contains(replaced, tag) {
+			replaced = append(replaced, tag)
+		}
+	}
+
+	return replaced, changed
+}
@@ -1,25 +1,35 @@
 package taskform
 
 import (
-	"strconv"
-
 	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
 )
 
 type focusAreaOption struct {
 	focusArea soqapi.FocusAreaDTO
+	meta      store.FocusAreaMeta
 }
 
-func NewFocusAreaOption(fa soqapi.FocusAreaDTO) *focusAreaOption {
+func NewFocusAreaOption(fa soqapi.FocusAreaDTO, meta store.FocusAreaMeta) *focusAreaOption {
 	return &focusAreaOption{
 		focusArea: fa,
+		meta:      meta,
 	}
 }
 
 func (f *focusAreaOption) Label() string {
-	return f.focusArea.Name
+	if f.meta.Glyph == "" {
+		return f.focusArea.Name
+	}
+
+	return f.meta.Glyph + " " + f.focusArea.Name
+}
+
+func (f *focusAreaOption) StyledLabel() string {
+	return styles.FocusAreaBadge(f.focusArea.Name, f.meta.Glyph, f.meta.Color)
 }
 
-func (f *focusAreaOption) Value() string {
-	return strconv.FormatUint(uint64(f.focusArea.ID), 10)
+func (f *focusAreaOption) Value() any {
+	return f.focusArea.ID
 }
@@ -1,17 +1,25 @@
 package taskform
 
 import (
+	"cmp"
 	"context"
 	"fmt"
-	"strconv"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	soqapi "github.com/mole-squad/soq-api/api"
 	"github.com/mole-squad/soq-tui/pkg/api"
 	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/entityform"
 	"github.com/mole-squad/soq-tui/pkg/forms"
 	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/recurrence"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/subtasks"
+	"github.com/mole-squad/soq-tui/pkg/tags"
 	"github.com/mole-squad/soq-tui/pkg/utils"
 )
 
@@ -19,42 +27,101 @@ const (
 	taskFormID       = "taskform"
 	summaryFieldID   = "summary"
 	notesFieldID     = "notes"
-	focusAreaFieldID = "focusarea"
+	subtasksFieldID  = "subtasks"
+	focusAreaFieldID = "focusAreaId"
+	priorityFieldID  = "priority"
+	dueFieldID       = "due"
+	dueTimeFieldID   = "dueTime"
+	repeatFieldID    = "repeat"
+	tagsFieldID      = "tags"
+
+	dueTimeFormat = "15:04"
 )
 
+var dueTimePattern = regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]$`)
+
+var schema = forms.NewSchema(
+	taskFormID,
+	forms.TextField(
+		summaryFieldID,
+		"Summary",
+		forms.WithValidators(forms.Required(), forms.MaxLength(255)),
+	),
+	forms.MarkdownField(notesFieldID, "Notes"),
+	forms.ChecklistField(subtasksFieldID, "Subtasks"),
+	forms.SelectField(
+		focusAreaFieldID,
+		"Focus Area",
+		forms.WithValidators(forms.Required()),
+	),
+	forms.SelectField(priorityFieldID, "Priority", forms.WithDefault(store.PriorityNone)),
+	forms.TagsField(tagsFieldID, "Tags"),
+	forms.DateField(dueFieldID, "Due date"),
+	forms.TextField(
+		dueTimeFieldID,
+		"Due time",
+		forms.WithHelp("Optional, as 24-hour hh:mm; without a time the task is due by the end of the day"),
+		forms.WithValidators(forms.Pattern(dueTimePattern, "a time such as 09:30 or 17:00")),
+		forms.WithVisibleIf(forms.FieldNotEmpty(dueFieldID)),
+	),
+	forms.TextField(
+		repeatFieldID,
+		"Repeat",
+		forms.WithHelp("Optional: "+recurrence.Syntax),
+		forms.WithValidators(validRecurrence()),
+	),
+)
+
+// taskEntity is the editable view of a task. The ID is not a form field and is
+// carried through from the task being edited. The priority and due date are
+// kept in the local task metadata, as are the repeat rule and the tags.
+// Subtasks are saved in a block at the end of the notes.
+type taskEntity struct {
+	ID          uint           `form:"-"`
+	Summary     string         `form:"summary"`
+	Notes       string         `form:"notes"`
+	Subtasks    string         `form:"subtasks"`
+	FocusAreaID uint           `form:"focusAreaId"`
+	Priority    store.Priority `form:"priority"`
+	Tags        string         `form:"tags"`
+	Due         string         `form:"due"`
+	DueTime     string         `form:"dueTime"`
+	Repeat      string         `form:"repeat"`
+}
+
+func (t taskEntity) notesWithSubtasks() string {
+	return subtasks.Join(t.Notes, subtasks.ParseChecklist(t.Subtasks))
+}
+
 type Model struct {
 	client *api.Client
 	logger *logger.Logger
-
-	isNewTask bool
-	task      soqapi.TaskDTO
+	store  *store.Store
 
 	focusareas []soqapi.FocusAreaDTO
 
-	form forms.Model
+	form entityform.Model[taskEntity]
 }
 
-func New(logger *logger.Logger, client *api.Client) common.AppView {
-	summary := forms.NewTextInput(summaryFieldID, "Summary")
-	notes := forms.NewTextInput(notesFieldID, "Notes")
-	focusArea := forms.NewSelectInput(focusAreaFieldID, "Focus Area")
-
-	form := forms.New(
-		taskFormID,
-		forms.WithField(summary),
-		forms.WithField(notes),
-		forms.WithField(focusArea),
-	)
-
-	return Model{
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	model := Model{
 		client: client,
 		logger: logger,
-		form:   form,
+		store:  store,
 	}
+
+	model.form = entityform.New(
+		schema,
+		common.AppStateTaskList,
+		model.saveTask,
+		forms.WithDrafts(store, logger),
+	)
+
+	return model
 }
 
 func (m Model) Init() tea.Cmd {
-	return m.form.Focus()
+	return m.form.Init()
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -62,15 +129,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case common.CreateTaskMsg:
-		return m, m.onTaskCreate()
+		m.form = m.form.ReturnTo(common.AppStateTaskList)
+		cmd = m.onTaskCreate()
+
+		return m, cmd
 
 	case common.SelectTaskMsg:
-		return m, m.onTaskSelect(msg.Task)
+		m.form = m.form.ReturnTo(msg.ReturnState)
+		cmd = m.onTaskSelect(msg.Task)
 
-	case forms.SubmitFormMsg:
-		if msg.FormID == taskFormID {
-			return m, m.submitTask()
-		}
+		return m, cmd
 	}
 
 	m.form, cmd = utils.ApplyUpdate(m.form, msg)
@@ -83,11 +151,17 @@ func (m Model) View() string {
 }
 
 func (m Model) Blur() (tea.Model, tea.Cmd) {
-	return m, m.form.Blur()
+	var cmd tea.Cmd
+	m.form, cmd = utils.ApplyBlur(m.form)
+
+	return m, cmd
 }
 
 func (m Model) Focus() (tea.Model, tea.Cmd) {
-	return m, m.form.Focus()
+	var cmd tea.Cmd
+	m.form, cmd = utils.ApplyFocus(m.form)
+
+	return m, cmd
 }
 
 func (m *Model) refreshFocusAreas() tea.Cmd {
@@ -103,9 +177,14 @@ func (m *Model) refreshFocusAreas() tea.Cmd {
 
 	m.logger.Debug("Focus areas fetched", "count", len(focusAreas))
 
+	areaMeta, err := m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
 	var opts = make([]forms.SelectOption, len(focusAreas))
 	for i, fa := range focusAreas {
-		opts[i] = NewFocusAreaOption(fa)
+		opts[i] = NewFocusAreaOption(fa, areaMeta[fa.ID])
 	}
 
 	m.focusareas = focusAreas
@@ -113,31 +192,44 @@ func (m *Model) refreshFocusAreas() tea.Cmd {
 	return forms.NewSetSelectOptionsCmd(focusAreaFieldID, opts)
 }
 
+// tagSuggestionsCmd offers the tags already in use, most used first.
+func (m Model) tagSuggestionsCmd() tea.Cmd {
+	counts, err := m.store.TagCounts()
+	if err != nil {
+		m.logger.Error("Error loading tags", "error", err)
+	}
+
+	known := make([]string, 0, len(counts))
+	for tag := range counts {
+		known = append(known, tag)
+	}
+
+	slices.SortFunc(known, func(a, b string) int {
+		return cmp.Or(counts[b]-counts[a], strings.Compare(a, b))
+	})
+
+	return forms.NewSetSuggestionsCmd(tagsFieldID, known)
+}
+
 func (m *Model) onTaskCreate() tea.Cmd {
 	refreshCmd := m.refreshFocusAreas()
 
 	m.logger.Debug("Creating new task")
 
 	if len(m.focusareas) == 0 {
-		return common.NewErrorMsg(fmt.Errorf("no focus areas available"))
+		return common.NewErrorMsg(fmt.Errorf("no focus areas available, create one from settings first"))
 	}
 
-	focusArea := m.focusareas[0]
-
-	m.isNewTask = true
-	m.task = soqapi.TaskDTO{
-		Summary:   "",
-		Notes:     "",
-		FocusArea: focusArea,
-	}
+	var setCmd tea.Cmd
+	m.form, setCmd = m.form.Create(taskEntity{
+		FocusAreaID: m.focusareas[0].ID,
+	})
 
 	return tea.Sequence(
 		refreshCmd,
-		tea.Batch(
-			forms.NewSetFieldValueCmd(taskFormID, summaryFieldID, m.task.Summary),
-			forms.NewSetFieldValueCmd(taskFormID, notesFieldID, m.task.Notes),
-			forms.NewSetFieldValueCmd(taskFormID, focusAreaFieldID, strconv.FormatUint(uint64(focusArea.ID), 10)),
-		),
+		forms.NewSetSelectOptionsCmd(priorityFieldID, priorityOptions()),
+		m.tagSuggestionsCmd(),
+		setCmd,
 	)
 }
 
@@ -150,77 +242,170 @@ func (m *Model) onTaskSelect(task soqapi.TaskDTO) tea.Cmd {
 		return common.NewErrorMsg(fmt.Errorf("no focus areas available"))
 	}
 
-	m.isNewTask = false
-	m.task = task
+	meta, err := m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	notes, items := subtasks.Split(task.Notes)
+
+	entity := taskEntity{
+		ID:          task.ID,
+		Summary:     task.Summary,
+		Notes:       notes,
+		Subtasks:    subtasks.FormatChecklist(items),
+		FocusAreaID: task.FocusArea.ID,
+		Priority:    meta[task.ID].Priority,
+		Tags:        tags.Format(meta[task.ID].Tags),
+		Repeat:      meta[task.ID].Recurrence,
+	}
+
+	if due := meta[task.ID].Due; due != nil {
+		entity.Due = due.At.Local().Format(forms.DateFormat)
+
+		if due.HasTime {
+			entity.DueTime = due.At.Local().Format(dueTimeFormat)
+		}
+	}
+
+	var setCmd tea.Cmd
+	m.form, setCmd = m.form.Edit(entity)
 
 	return tea.Sequence(
 		refreshCmd,
-		tea.Batch(
-			forms.NewSetFieldValueCmd(taskFormID, summaryFieldID, m.task.Summary),
-			forms.NewSetFieldValueCmd(taskFormID, notesFieldID, m.task.Notes),
-			forms.NewSetFieldValueCmd(taskFormID, focusAreaFieldID, strconv.FormatUint(uint64(task.FocusArea.ID), 10)),
-		),
+		forms.NewSetSelectOptionsCmd(priorityFieldID, priorityOptions()),
+		m.tagSuggestionsCmd(),
+		setCmd,
 	)
 }
 
-func (m *Model) submitTask() tea.Cmd {
-	values := m.form.Value()
-
-	summary := values[summaryFieldID]
-	notes := values[notesFieldID]
-	focusAreaID, err := strconv.ParseUint(values[focusAreaFieldID], 10, 64)
-	if err != nil {
-		return common.NewErrorMsg(fmt.Errorf("error parsing focus area ID: %w", err))
-	}
+func (m Model) saveTask(task taskEntity, isNew bool) error {
+	var err error
 
-	// TODO validation
-
-	if m.isNewTask {
-		err = m.createTask(summary, notes, uint(focusAreaID))
+	if isNew {
+		err = m.createTask(task)
 	} else {
-		err = m.updateTask(summary, notes, uint(focusAreaID))
+		err = m.updateTask(task)
 	}
 
 	if err != nil {
 		m.logger.Error("Error submitting task", "error", err)
-		return common.NewErrorMsg(err)
+		return err
 	}
 
-	return common.AppStateCmd(common.AppStateTaskList)
+	return nil
 }
 
-func (m *Model) createTask(summary, notes string, focusAreaID uint) error {
+func (m Model) createTask(task taskEntity) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
 	dto := soqapi.CreateTaskRequestDTO{
-		Summary:     summary,
-		Notes:       notes,
-		FocusAreaID: focusAreaID,
+		Summary:     task.Summary,
+		Notes:       task.notesWithSubtasks(),
+		FocusAreaID: task.FocusAreaID,
 	}
 
-	_, err := m.client.CreateTask(ctx, &dto)
+	created, err := m.client.CreateTask(ctx, &dto)
 	if err != nil {
 		return fmt.Errorf("error creating task: %w", err)
 	}
 
+	m.touchTask(created.ID, true, task)
+
 	return nil
 }
 
-func (m *Model) updateTask(summary, notes string, focusAreaID uint) error {
+func (m Model) updateTask(task taskEntity) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
 	dto := soqapi.UpdateTaskRequestDTO{
-		Summary:     summary,
-		Notes:       notes,
-		FocusAreaID: focusAreaID,
+		Summary:     task.Summary,
+		Notes:       task.notesWithSubtasks(),
+		FocusAreaID: task.FocusAreaID,
 	}
 
-	_, err := m.client.UpdateTask(ctx, m.task.ID, &dto)
+	_, err := m.client.UpdateTask(ctx, task.ID, &dto)
 	if err != nil {
 		return fmt.Errorf("error updating task: %w", err)
 	}
 
+	m.touchTask(task.ID, false, task)
+
 	return nil
 }
+
+// touchTask records local timestamps, the priority, the tags, the due date
+// and the repeat rule of a saved task. Failing to record them does not fail the save.
+func (m Model) touchTask(taskID uint, isNew bool, task taskEntity) {
+	if err := m.store.TouchTask(taskID, isNew); err != nil {
+		m.logger.Error("Error recording task timestamps", "error", err)
+	}
+
+	due, err := parseDue(task.Due, task.DueTime)
+	if err != nil {
+		m.logger.Error("Error reading due date", "error", err)
+	}
+
+	repeat := ""
+	if task.Repeat != "" {
+		rule, err := recurrence.Parse(task.Repeat)
+		if err != nil {
+			m.logger.Error("Error reading repeat rule", "error", err)
+		} else {
+			repeat = rule.String()
+		}
+	}
+
+	err = m.store.UpdateTaskMeta(taskID, func(meta *store.TaskMeta) {
+		meta.Priority = task.Priority
+		meta.Tags = tags.Parse(task.Tags)
+		meta.Recurrence = repeat
+
+		// Keep the reminder state unless the due date moved.
+		if due == nil || meta.Due == nil || !meta.Due.SameAs(*due) {
+			meta.Due = due
+		}
+	})
+	if err != nil {
+		m.logger.Error("Error recording task metadata", "error", err)
+	}
+}
+
+// parseDue combines the due date and time fields. It returns nil without a
+// due date.
+func parseDue(date string, clock string) (*store.DueDate, error) {
+	if date == "" {
+		return nil, nil
+	}
+
+	if clock == "" {
+		day, err := time.ParseInLocation(forms.DateFormat, date, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing due date: %w", err)
+		}
+
+		return &store.DueDate{At: day}, nil
+	}
+
+	at, err := time.ParseInLocation(forms.DateFormat+" "+dueTimeFormat, date+" "+clock, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing due date: %w", err)
+	}
+
+	return &store.DueDate{At: at, HasTime: true}, nil
+}
+
+func validRecurrence() forms.Validator {
+	return func(value any) error {
+		text, _ := value.(string)
+		if text == "" {
+			return nil
+		}
+
+		_, err := recurrence.Parse(text)
+
+		return err
+	}
+}
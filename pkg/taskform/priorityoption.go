@@ -0,0 +1,30 @@
+package taskform
+
+import (
+	"github.com/mole-squad/soq-tui/pkg/forms"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+type priorityOption struct {
+	priority store.Priority
+}
+
+func (p priorityOption) Label() string {
+	return p.priority.Label()
+}
+
+func (p priorityOption) Value() any {
+	return p.priority
+}
+
+// priorityOptions lists the priorities from highest to lowest, so the most
+// urgent is at the top of the picker.
+func priorityOptions() []forms.SelectOption {
+	opts := make([]forms.SelectOption, 0, len(store.Priorities))
+
+	for idx := len(store.Priorities) - 1; idx >= 0; idx-- {
+		opts = append(opts, priorityOption{priority: store.Priorities[idx]})
+	}
+
+	return opts
+}
@@ -0,0 +1,232 @@
+package tasklist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/bulk"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/resolve"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskops"
+)
+
+type bulkAction int
+
+const (
+	bulkResolve bulkAction = iota
+	bulkDelete
+	bulkMove
+	bulkReopen
+)
+
+func (a bulkAction) verbs() (present string, past string) {
+	switch a {
+	case bulkDelete:
+		return "Deleting", "deleted"
+	case bulkMove:
+		return "Moving", "moved"
+	case bulkReopen:
+		return "Reopening", "reopened"
+	}
+
+	return "Resolving", "resolved"
+}
+
+// confirmAction is the preference that decides whether the action asks first.
+func (a bulkAction) confirmAction() string {
+	if a == bulkDelete {
+		return store.ConfirmDeleteTask
+	}
+
+	return store.ConfirmResolveTask
+}
+
+type bulkDoneMsg struct {
+	action  bulkAction
+	results []bulk.Result[soqapi.TaskDTO]
+}
+
+var bulkReportStyle = lipgloss.NewStyle().Padding(1, 0, 0, 2)
+
+// startBulk runs an action against the target tasks in the background.
+func (m Model) startBulk(action bulkAction, focusArea soqapi.FocusAreaDTO) (Model, tea.Cmd) {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 || m.bulkRunning {
+		return m, nil
+	}
+
+	m.bulkRunning = true
+	m.bulkReport = nil
+
+	present, _ := action.verbs()
+	statusCmd := m.teaList.NewStatusMessage(fmt.Sprintf("%s %s…", present, pluralTasks(len(tasks))))
+
+	run := func() tea.Msg {
+		results := bulk.Run(context.Background(), tasks, bulk.DefaultLimit, func(ctx context.Context, task soqapi.TaskDTO) error {
+			ctx, cancel := context.WithTimeout(ctx, common.DefaultRequestTimeout)
+			defer cancel()
+
+			return m.applyBulkAction(ctx, action, task, focusArea)
+		})
+
+		return bulkDoneMsg{action: action, results: results}
+	}
+
+	return m, tea.Batch(statusCmd, run)
+}
+
+func (m Model) applyBulkAction(ctx context.Context, action bulkAction, task soqapi.TaskDTO, focusArea soqapi.FocusAreaDTO) error {
+	switch action {
+	case bulkDelete:
+		if err := m.client.DeleteTask(ctx, task.ID); err != nil {
+			return err
+		}
+
+		if err := m.store.DeleteTaskMeta(task.ID); err != nil {
+			m.logger.Error("Error deleting task metadata", "error", err)
+		}
+
+	case bulkMove:
+		return taskops.Move(ctx, m.client, m.store, m.logger, task, focusArea.ID)
+
+	case bulkReopen:
+		if err := m.deleteNextInstance(ctx, task.ID); err != nil {
+			return err
+		}
+
+		if err := taskops.Recreate(ctx, m.client, m.store, m.logger, task, task.FocusArea.ID, m.meta[task.ID]); err != nil {
+			return err
+		}
+
+		if err := m.store.ForgetResolvedTask(task.ID); err != nil {
+			m.logger.Error("Error updating resolved tasks", "error", err)
+		}
+
+	default:
+		_, err := resolve.Task(ctx, m.client, m.store, m.logger, task, m.meta[task.ID])
+		return err
+	}
+
+	return nil
+}
+
+// onBulkDone unmarks the tasks that succeeded and offers to undo them, and
+// keeps a report of the ones that failed until the next key press.
+func (m Model) onBulkDone(msg bulkDoneMsg) (Model, tea.Cmd) {
+	m.bulkRunning = false
+
+	failed := bulk.Failed(msg.results)
+
+	succeeded := make([]soqapi.TaskDTO, 0, len(msg.results))
+	for _, result := range msg.results {
+		if result.Err == nil {
+			delete(m.marked, result.Item.ID)
+			succeeded = append(succeeded, result.Item)
+		}
+	}
+
+	m.bulkReport = make([]string, 0, len(failed))
+	for _, result := range failed {
+		m.logger.Error("Bulk action failed", "task", result.Item.ID, "error", result.Err)
+		m.bulkReport = append(m.bulkReport, fmt.Sprintf("✗ #%d %s: %s", result.Item.ID, result.Item.Summary, result.Err))
+	}
+
+	_, past := msg.action.verbs()
+
+	status := fmt.Sprintf("%s %s", pluralTasks(len(msg.results)), past)
+	if len(failed) > 0 {
+		status = fmt.Sprintf("%d of %s %s, %d failed", len(succeeded), pluralTasks(len(msg.results)), past, len(failed))
+	}
+
+	m, undoCmd := m.pushUndo(msg.action, succeeded)
+	m, refreshCmd := m.refreshTasks()
+	m.resize()
+
+	return m, tea.Batch(refreshCmd, undoCmd, m.teaList.NewStatusMessage(status))
+}
+
+func (m Model) startMove() (Model, tea.Cmd) {
+	if len(m.focusAreas) == 0 || len(m.targetTasks()) == 0 {
+		return m, nil
+	}
+
+	m.moving = true
+	m.moveIdx = 0
+	m.resize()
+
+	return m, nil
+}
+
+func (m Model) onMoveKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.NextMoveTarget):
+		m.moveIdx = (m.moveIdx + 1) % len(m.focusAreas)
+
+	case key.Matches(msg, m.keys.PrevMoveTarget):
+		m.moveIdx = (m.moveIdx - 1 + len(m.focusAreas)) % len(m.focusAreas)
+
+	case key.Matches(msg, m.keys.ConfirmMove):
+		m.moving = false
+		m.resize()
+
+		return m.startBulk(bulkMove, m.focusAreas[m.moveIdx])
+
+	case key.Matches(msg, m.keys.CancelMove):
+		m.moving = false
+		m.resize()
+	}
+
+	return m, nil
+}
+
+func (m Model) renderMovePicker() string {
+	tabs := make([]string, len(m.focusAreas))
+
+	for idx, fa := range m.focusAreas {
+		style := inactiveTabStyle
+		if idx == m.moveIdx {
+			style = activeTabStyle
+		}
+
+		label := fa.Name
+		if glyph := m.areaMeta[fa.ID].Glyph; glyph != "" {
+			label = glyph + " " + label
+		}
+
+		tabs[idx] = style.Render(label)
+	}
+
+	return bulkReportStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.InputLabelStyle.Render(fmt.Sprintf("Move %s to:", pluralTasks(len(m.targetTasks())))),
+		strings.Join(tabs, " "),
+		styles.InputHelpStyle.Render("←/→ choose • enter move • esc cancel"),
+	))
+}
+
+func (m Model) renderBulkReport() string {
+	lines := make([]string, 0, len(m.bulkReport)+1)
+
+	for _, line := range m.bulkReport {
+		lines = append(lines, styles.InputErrorStyle.Render(line))
+	}
+
+	lines = append(lines, styles.InputHelpStyle.Render("press any key to dismiss"))
+
+	return bulkReportStyle.Width(m.width).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func pluralTasks(count int) string {
+	if count == 1 {
+		return "1 task"
+	}
+
+	return fmt.Sprintf("%d tasks", count)
+}
@@ -0,0 +1,101 @@
+package tasklist
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestApplyBulkReopenRepeatingTask(t *testing.T) {
+	dir := t.TempDir()
+
+	// The logger writes debug.log to the working directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	var requests []string
+
+	transport := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		res := &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}
+
+		if req.Method == http.MethodPost {
+			res.StatusCode = http.StatusCreated
+			res.Body = io.NopCloser(strings.NewReader(`{"id": 9, "summary": "Water the plants"}`))
+		}
+
+		return res, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	log := logger.New(false)
+	s := store.New(dir)
+
+	task := soqapi.TaskDTO{ID: 7, Summary: "Water the plants", FocusArea: soqapi.FocusAreaDTO{ID: 1}}
+	meta := store.TaskMeta{Recurrence: "weekly on mon"}
+
+	if err := s.RecordResolvedTask(store.ResolvedTask{Task: task, NextID: 8}); err != nil {
+		t.Fatal(err)
+	}
+
+	m := Model{
+		client: api.NewClient(log, dir),
+		store:  s,
+		logger: log,
+		meta:   map[uint]store.TaskMeta{task.ID: meta},
+	}
+
+	if err := m.applyBulkAction(context.Background(), bulkReopen, task, soqapi.FocusAreaDTO{}); err != nil {
+		t.Fatalf("applyBulkAction returned error: %v", err)
+	}
+
+	if want := []string{"DELETE /tasks/8", "POST /tasks"}; !slices.Equal(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+
+	history, err := s.LoadResolvedTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 0 {
+		t.Errorf("resolved history = %+v, want it empty", history)
+	}
+
+	restored, err := s.LoadTaskMeta()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if restored[9].Recurrence != meta.Recurrence {
+		t.Errorf("recreated task recurrence = %q, want %q", restored[9].Recurrence, meta.Recurrence)
+	}
+}
@@ -0,0 +1,153 @@
+package tasklist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/markdown"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/subtasks"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+)
+
+const (
+	// minDetailWidth is the narrowest terminal that still shows the detail pane.
+	minDetailWidth = 90
+	minPanelWidth  = 32
+
+	timestampFormat = "Jan 2, 2006 15:04"
+
+	// repeatMarker flags repeating tasks in the list.
+	repeatMarker = "↻"
+)
+
+var (
+	detailTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(styles.HotPink)
+
+	detailLabelStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Width(12)
+
+	detailEmptyStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Italic(true)
+)
+
+// renderTaskDetail renders a task with its notes as Markdown. The checklist
+// item at checklistIdx is marked as selected. The subtasks block is left out
+// of the notes, as the checklist cursor skips it.
+func renderTaskDetail(item TaskListItem, meta store.TaskMeta, checklistIdx int, width int) string {
+	task := item.task
+
+	body, _ := subtasks.Split(task.Notes)
+
+	notes := detailEmptyStyle.Render("No notes")
+	if body != "" {
+		notes = markdown.Render(markdown.MarkChecklistItem(body, checklistIdx), width)
+	}
+
+	rows := []string{
+		detailTitleStyle.Width(width).Render(task.Summary),
+		"",
+		renderDetailRow("ID", fmt.Sprintf("#%d", task.ID)),
+		renderDetailRow("Focus area", item.badge),
+		renderDetailRow("Priority", item.priority.Label()),
+		renderDetailRow("Tags", formatTags(item)),
+		renderDetailRow("Due", formatDue(item)),
+		renderDetailRow("Repeats", formatRepeats(item)),
+		renderDetailRow("Snoozed", formatSnooze(meta, time.Now())),
+		renderDetailRow("Subtasks", formatSubtasks(item)),
+		renderDetailRow("Pomodoros", fmt.Sprint(item.sessions)),
+		renderDetailRow("Tracked", formatTracked(item)),
+	}
+
+	// Created and updated times are only recorded by this client, so tasks
+	// from other clients have none.
+	if !meta.CreatedAt.IsZero() {
+		rows = append(rows, renderDetailRow("Created", formatTimestamp(meta.CreatedAt)))
+	}
+
+	if !meta.UpdatedAt.IsZero() {
+		rows = append(rows, renderDetailRow("Updated", formatTimestamp(meta.UpdatedAt)))
+	}
+
+	if !item.resolvedAt.IsZero() {
+		rows = append(rows, renderDetailRow("Resolved", formatTimestamp(item.resolvedAt)))
+	}
+
+	rows = append(rows, "", styles.InputLabelStyle.Render("Notes"), notes)
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+func renderDetailRow(label, value string) string {
+	return lipgloss.JoinHorizontal(lipgloss.Top, detailLabelStyle.Render(label), value)
+}
+
+// formatTimestamp renders a locally recorded time.
+func formatTimestamp(t time.Time) string {
+	return t.Local().Format(timestampFormat)
+}
+
+func formatTracked(item TaskListItem) string {
+	tracked := timetracking.FormatDuration(item.tracked)
+	if item.tracking {
+		tracked += " · running"
+	}
+
+	return tracked
+}
+
+func formatSubtasks(item TaskListItem) string {
+	progress := formatProgress(item.subtasks)
+	if progress == "" {
+		return detailEmptyStyle.Render("none")
+	}
+
+	return progress + " done"
+}
+
+func formatTags(item TaskListItem) string {
+	if len(item.tags) == 0 {
+		return detailEmptyStyle.Render("none")
+	}
+
+	chips := make([]string, len(item.tags))
+	for idx, tag := range item.tags {
+		chips[idx] = styles.TagChip(tag)
+	}
+
+	return strings.Join(chips, " ")
+}
+
+func formatSnooze(meta store.TaskMeta, now time.Time) string {
+	switch {
+	case meta.IsSnoozed(now):
+		return "until " + meta.SnoozedUntil.Local().Format(timestampFormat)
+	case meta.IsWoken(now):
+		return "back today"
+	}
+
+	return detailEmptyStyle.Render("no")
+}
+
+func formatRepeats(item TaskListItem) string {
+	if item.repeats == "" {
+		return detailEmptyStyle.Render("never")
+	}
+
+	return item.repeats
+}
+
+func formatDue(item TaskListItem) string {
+	if item.due == nil {
+		return detailEmptyStyle.Render("none")
+	}
+
+	return item.dueBadge
+}
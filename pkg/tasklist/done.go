@@ -0,0 +1,61 @@
+package tasklist
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+// toggleDone switches between open tasks and the local history of resolved
+// tasks.
+func (m Model) toggleDone() (Model, tea.Cmd) {
+	m.showingDone = !m.showingDone
+
+	m.keys.setDoneMode(m.showingDone)
+	m.teaList.AdditionalShortHelpKeys = m.keys.shortHelp
+	m.teaList.AdditionalFullHelpKeys = m.keys.fullHelp
+
+	m.clearMarks()
+	m.teaList.ResetSelected()
+	m.filterBar.setFocusAreas(m.focusAreas, m.areaMeta, m.sourceTasks())
+
+	cmd := m.setItems()
+	m.resize()
+
+	return m, cmd
+}
+
+// sourceTasks returns the tasks the list is built from: open tasks, without
+// snoozed ones unless those are shown, or resolved ones newest first.
+func (m Model) sourceTasks() []soqapi.TaskDTO {
+	if !m.showingDone {
+		if m.showSnoozed {
+			return m.tasks
+		}
+
+		return taskquery.WithoutSnoozed(m.tasks, m.meta, time.Now())
+	}
+
+	tasks := make([]soqapi.TaskDTO, len(m.resolved))
+	for idx, resolved := range m.resolved {
+		tasks[idx] = resolved.Task
+	}
+
+	return tasks
+}
+
+func (m Model) resolvedAt(taskID uint) time.Time {
+	if !m.showingDone {
+		return time.Time{}
+	}
+
+	for _, resolved := range m.resolved {
+		if resolved.Task.ID == taskID {
+			return resolved.ResolvedAt
+		}
+	}
+
+	return time.Time{}
+}
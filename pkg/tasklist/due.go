@@ -0,0 +1,27 @@
+package tasklist
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+var dueStyles = map[taskquery.AgendaBucket]lipgloss.Style{
+	taskquery.BucketOverdue:  lipgloss.NewStyle().Foreground(styles.ErrorRed).Bold(true),
+	taskquery.BucketToday:    lipgloss.NewStyle().Foreground(styles.Amber),
+	taskquery.BucketThisWeek: lipgloss.NewStyle().Foreground(styles.DarkGray),
+	taskquery.BucketLater:    lipgloss.NewStyle().Foreground(styles.DarkGray),
+}
+
+// renderDueBadge renders a due date, highlighted when the task is overdue or
+// due today. It is empty without a due date.
+func renderDueBadge(due *store.DueDate, now time.Time) string {
+	if due == nil {
+		return ""
+	}
+
+	return dueStyles[taskquery.BucketOf(*due, now)].Render(taskquery.DueLabel(*due, now))
+}
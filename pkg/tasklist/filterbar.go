@@ -0,0 +1,154 @@
+package tasklist
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+var (
+	filterBarStyle = lipgloss.NewStyle().
+			Padding(0, 0, 1, 2)
+
+	activeTabStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Background(styles.HotPink).
+			Padding(0, 1)
+
+	inactiveTabStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Padding(0, 1)
+)
+
+type filterTab struct {
+	focusAreaID uint
+	label       string
+	count       int
+}
+
+// filterBar is the row of focus area tabs above the task list, followed by a
+// tab that switches to resolved tasks.
+type filterBar struct {
+	tabs      []filterTab
+	doneCount int
+	prefix    string
+}
+
+func newFilterBar() filterBar {
+	return filterBar{
+		prefix: zone.NewPrefix(),
+	}
+}
+
+// setFocusAreas rebuilds the tabs, starting with one for all focus areas.
+func (b *filterBar) setFocusAreas(focusAreas []soqapi.FocusAreaDTO, meta map[uint]store.FocusAreaMeta, tasks []soqapi.TaskDTO) {
+	counts := taskquery.CountByFocusArea(tasks)
+
+	b.tabs = []filterTab{
+		{focusAreaID: taskquery.AllFocusAreas, label: "All", count: len(tasks)},
+	}
+
+	for _, fa := range focusAreas {
+		label := fa.Name
+		if glyph := meta[fa.ID].Glyph; glyph != "" {
+			label = glyph + " " + label
+		}
+
+		b.tabs = append(b.tabs, filterTab{
+			focusAreaID: fa.ID,
+			label:       label,
+			count:       counts[fa.ID],
+		})
+	}
+}
+
+func (b filterBar) has(focusAreaID uint) bool {
+	for _, tab := range b.tabs {
+		if tab.focusAreaID == focusAreaID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cycle returns the focus area of the tab delta steps away from active.
+func (b filterBar) cycle(active uint, delta int) uint {
+	if len(b.tabs) == 0 {
+		return taskquery.AllFocusAreas
+	}
+
+	current := 0
+	for idx, tab := range b.tabs {
+		if tab.focusAreaID == active {
+			current = idx
+		}
+	}
+
+	next := (current + delta + len(b.tabs)) % len(b.tabs)
+
+	return b.tabs[next].focusAreaID
+}
+
+func (b *filterBar) setDoneCount(count int) {
+	b.doneCount = count
+}
+
+// doneClicked reports whether the done tab is under the cursor.
+func (b filterBar) doneClicked(msg tea.MouseMsg) bool {
+	return mouse.IsLeftClick(msg) && zone.Get(b.prefix+"done").InBounds(msg)
+}
+
+// clicked returns the focus area of the tab under the cursor.
+func (b filterBar) clicked(msg tea.MouseMsg) (uint, bool) {
+	if !mouse.IsLeftClick(msg) {
+		return 0, false
+	}
+
+	for _, tab := range b.tabs {
+		if zone.Get(b.tabZoneID(tab)).InBounds(msg) {
+			return tab.focusAreaID, true
+		}
+	}
+
+	return 0, false
+}
+
+func (b filterBar) render(active uint, showingDone bool, width int) string {
+	rendered := make([]string, len(b.tabs), len(b.tabs)+2)
+
+	for idx, tab := range b.tabs {
+		style := inactiveTabStyle
+		if tab.focusAreaID == active {
+			style = activeTabStyle
+		}
+
+		label := style.Render(fmt.Sprintf("%s %d", tab.label, tab.count))
+		rendered[idx] = zone.Mark(b.tabZoneID(tab), label)
+	}
+
+	doneStyle := inactiveTabStyle
+	if showingDone {
+		doneStyle = activeTabStyle
+	}
+
+	rendered = append(rendered,
+		styles.InputHelpStyle.Render("│"),
+		zone.Mark(b.prefix+"done", doneStyle.Render(fmt.Sprintf("✓ Done %d", b.doneCount))),
+	)
+
+	return filterBarStyle.MaxWidth(width).Render(strings.Join(rendered, " "))
+}
+
+func (b filterBar) tabZoneID(tab filterTab) string {
+	return fmt.Sprintf("%stab-%d", b.prefix, tab.focusAreaID)
+}
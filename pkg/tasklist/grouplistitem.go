@@ -0,0 +1,140 @@
+package tasklist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+// priorityColumnWidth is the width of the priority indicator in front of each
+// task.
+const priorityColumnWidth = 4
+
+var (
+	groupHeaderStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(styles.HotPink).
+				Padding(0, 0, 0, 2)
+
+	priorityStyles = map[store.Priority]lipgloss.Style{
+		store.PriorityLow:    lipgloss.NewStyle().Foreground(styles.DarkGray),
+		store.PriorityMedium: lipgloss.NewStyle().Foreground(styles.Amber),
+		store.PriorityHigh:   lipgloss.NewStyle().Foreground(styles.ErrorRed).Bold(true),
+	}
+)
+
+// GroupHeaderItem is a section header in the grouped task list. It cannot be
+// selected; the cursor skips over it.
+type GroupHeaderItem struct {
+	focusArea soqapi.FocusAreaDTO
+	meta      store.FocusAreaMeta
+	count     int
+}
+
+func (g GroupHeaderItem) FilterValue() string {
+	return ""
+}
+
+// taskDelegate renders tasks with the default delegate behind a priority
+// column, group headers as a labelled rule and subtasks as a tree under their
+// task.
+type taskDelegate struct {
+	list.DefaultDelegate
+}
+
+func newTaskDelegate() taskDelegate {
+	return taskDelegate{
+		DefaultDelegate: list.NewDefaultDelegate(),
+	}
+}
+
+// Height makes room for a blank line above every task and header, which
+// replaces the list spacing so that subtasks follow their task without a gap.
+func (d taskDelegate) Height() int {
+	return d.DefaultDelegate.Height() + d.DefaultDelegate.Spacing()
+}
+
+func (d taskDelegate) Spacing() int {
+	return 0
+}
+
+func (d taskDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	if taskItem, ok := item.(TaskListItem); ok {
+		d.renderTask(w, m, index, taskItem)
+		return
+	}
+
+	if subtaskItem, ok := item.(SubtaskItem); ok {
+		d.renderSubtasks(w, m, subtaskItem)
+		return
+	}
+
+	header, ok := item.(GroupHeaderItem)
+	if !ok {
+		d.DefaultDelegate.Render(w, m, index, item)
+		return
+	}
+
+	noun := "tasks"
+	if header.count == 1 {
+		noun = "task"
+	}
+
+	name := header.focusArea.Name
+	if header.meta.Glyph != "" {
+		name = header.meta.Glyph + " " + name
+	}
+
+	style := groupHeaderStyle
+	if header.meta.Color != "" {
+		style = style.Foreground(lipgloss.Color(header.meta.Color))
+	}
+
+	label := style.Render(fmt.Sprintf("%s · %d %s ", name, header.count, noun))
+
+	rule := ""
+	if gap := m.Width() - lipgloss.Width(label); gap > 0 {
+		rule = styles.InputHelpStyle.Render(strings.Repeat("─", gap))
+	}
+
+	fmt.Fprint(w, strings.Repeat("\n", d.Height()-2)+label+rule+"\n")
+}
+
+func (d taskDelegate) renderTask(w io.Writer, m list.Model, index int, item TaskListItem) {
+	// The default delegate sizes text to the list, so give it a copy that
+	// leaves room for the priority column.
+	narrowed := m
+	narrowed.SetWidth(m.Width() - priorityColumnWidth)
+
+	var rendered strings.Builder
+	d.DefaultDelegate.Render(&rendered, narrowed, index, item)
+
+	lines := strings.Split(rendered.String(), "\n")
+	for idx := range lines {
+		column := strings.Repeat(" ", priorityColumnWidth)
+		if idx == 0 {
+			column = renderPriority(item.priority)
+		}
+
+		lines[idx] = column + lines[idx]
+	}
+
+	fmt.Fprint(w, strings.Repeat("\n", d.DefaultDelegate.Spacing())+strings.Join(lines, "\n"))
+}
+
+func renderPriority(priority store.Priority) string {
+	marks := fmt.Sprintf("%*s ", priorityColumnWidth-1, strings.Repeat("!", int(priority)))
+
+	style, ok := priorityStyles[priority]
+	if !ok {
+		return marks
+	}
+
+	return style.Render(marks)
+}
@@ -10,6 +10,67 @@ type keyMap struct {
 	Delete   key.Binding
 	Resolve  key.Binding
 	Settings key.Binding
+	Move     key.Binding
+	Undo     key.Binding
+	Snooze   key.Binding
+
+	ToggleDone key.Binding
+	Reopen     key.Binding
+	Board      key.Binding
+	FocusTimer key.Binding
+	TrackTime  key.Binding
+	TimeLog    key.Binding
+	Agenda     key.Binding
+	Review     key.Binding
+
+	ToggleMark key.Binding
+	MarkRange  key.Binding
+	MarkAll    key.Binding
+	ClearMarks key.Binding
+
+	NextMoveTarget key.Binding
+	PrevMoveTarget key.Binding
+	ConfirmMove    key.Binding
+	CancelMove     key.Binding
+
+	ToggleSnoozed    key.Binding
+	NextSnoozeOption key.Binding
+	PrevSnoozeOption key.Binding
+	ConfirmSnooze    key.Binding
+	CancelSnooze     key.Binding
+
+	Search      key.Binding
+	FilterByTag key.Binding
+	ApplySearch key.Binding
+	ClearSearch key.Binding
+
+	NextFocusArea key.Binding
+	PrevFocusArea key.Binding
+	ToggleGroups  key.Binding
+
+	ToggleSubtasks key.Binding
+
+	CycleSort   key.Binding
+	ReverseSort key.Binding
+
+	MoveUp        key.Binding
+	MoveDown      key.Binding
+	MoveToTop     key.Binding
+	MoveToBottom  key.Binding
+	RaisePriority key.Binding
+	LowerPriority key.Binding
+
+	NextView        key.Binding
+	SaveView        key.Binding
+	ConfirmViewName key.Binding
+	CancelViewName  key.Binding
+
+	ScrollDetailDown key.Binding
+	ScrollDetailUp   key.Binding
+
+	NextChecklistItem   key.Binding
+	PrevChecklistItem   key.Binding
+	ToggleChecklistItem key.Binding
 }
 
 func newKeyMap() keyMap {
@@ -34,5 +95,286 @@ func newKeyMap() keyMap {
 			key.WithKeys(","),
 			key.WithHelp(",", "settings"),
 		),
+		Move: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "move to focus area"),
+		),
+		ToggleDone: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "done tasks"),
+		),
+		Board: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "board"),
+		),
+		FocusTimer: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "focus timer"),
+		),
+		TrackTime: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "start / stop tracking"),
+		),
+		TimeLog: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "time log"),
+		),
+		Agenda: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "agenda"),
+		),
+		Review: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "review tasks"),
+		),
+		Reopen: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "reopen task"),
+			key.WithDisabled(),
+		),
+		Undo: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "undo"),
+		),
+		ToggleMark: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "select"),
+		),
+		MarkRange: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "select range"),
+		),
+		MarkAll: key.NewBinding(
+			key.WithKeys("*"),
+			key.WithHelp("*", "select all"),
+		),
+		ClearMarks: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "clear selection"),
+		),
+		NextMoveTarget: key.NewBinding(
+			key.WithKeys("right", "l", "tab"),
+			key.WithHelp("→", "next focus area"),
+		),
+		PrevMoveTarget: key.NewBinding(
+			key.WithKeys("left", "h", "shift+tab"),
+			key.WithHelp("←", "previous focus area"),
+		),
+		ConfirmMove: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "move"),
+		),
+		CancelMove: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		Snooze: key.NewBinding(
+			key.WithKeys("Z"),
+			key.WithHelp("Z", "snooze"),
+		),
+		ToggleSnoozed: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "show / hide snoozed"),
+		),
+		NextSnoozeOption: key.NewBinding(
+			key.WithKeys("right", "l", "tab"),
+			key.WithHelp("→", "next"),
+		),
+		PrevSnoozeOption: key.NewBinding(
+			key.WithKeys("left", "h", "shift+tab"),
+			key.WithHelp("←", "previous"),
+		),
+		ConfirmSnooze: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "snooze"),
+		),
+		CancelSnooze: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		FilterByTag: key.NewBinding(
+			key.WithKeys("#"),
+			key.WithHelp("#", "filter by tag"),
+		),
+		ApplySearch: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply search"),
+		),
+		ClearSearch: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "clear search"),
+		),
+		NextFocusArea: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next focus area"),
+		),
+		PrevFocusArea: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous focus area"),
+		),
+		ToggleGroups: key.NewBinding(
+			key.WithKeys("g"),
+			key.WithHelp("g", "group by focus area"),
+		),
+		ToggleSubtasks: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "show / hide subtasks"),
+		),
+		CycleSort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "sort by"),
+		),
+		ReverseSort: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "reverse sort"),
+		),
+		MoveUp: key.NewBinding(
+			key.WithKeys("alt+up", "alt+k"),
+			key.WithHelp("alt+↑", "move task up"),
+		),
+		MoveDown: key.NewBinding(
+			key.WithKeys("alt+down", "alt+j"),
+			key.WithHelp("alt+↓", "move task down"),
+		),
+		MoveToTop: key.NewBinding(
+			key.WithKeys("alt+home", "alt+g"),
+			key.WithHelp("alt+g", "move task to top"),
+		),
+		MoveToBottom: key.NewBinding(
+			key.WithKeys("alt+end", "alt+G"),
+			key.WithHelp("alt+G", "move task to bottom"),
+		),
+		RaisePriority: key.NewBinding(
+			key.WithKeys("+", "="),
+			key.WithHelp("+", "raise priority"),
+		),
+		LowerPriority: key.NewBinding(
+			key.WithKeys("-"),
+			key.WithHelp("-", "lower priority"),
+		),
+		NextView: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "next saved view"),
+		),
+		SaveView: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "save view"),
+		),
+		ConfirmViewName: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "save"),
+		),
+		CancelViewName: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		ScrollDetailDown: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "scroll details down"),
+		),
+		ScrollDetailUp: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "scroll details up"),
+		),
+		NextChecklistItem: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next checklist item"),
+		),
+		PrevChecklistItem: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous checklist item"),
+		),
+		ToggleChecklistItem: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "check / uncheck item"),
+		),
+	}
+}
+
+// shortHelp and fullHelp list the keys shown below the task list. Disabled
+// bindings are hidden.
+func (k keyMap) shortHelp() []key.Binding {
+	return []key.Binding{
+		k.New,
+		k.Edit,
+		k.Delete,
+		k.Resolve,
+		k.Reopen,
+		k.ToggleMark,
+		k.Search,
+		k.ToggleDone,
+	}
+}
+
+func (k keyMap) fullHelp() []key.Binding {
+	return []key.Binding{
+		k.New,
+		k.Edit,
+		k.Delete,
+		k.Resolve,
+		k.Reopen,
+		k.ToggleDone,
+		k.Snooze,
+		k.ToggleSnoozed,
+		k.Board,
+		k.Agenda,
+		k.Review,
+		k.FocusTimer,
+		k.TrackTime,
+		k.TimeLog,
+		k.Settings,
+		k.ToggleMark,
+		k.MarkRange,
+		k.MarkAll,
+		k.ClearMarks,
+		k.Move,
+		k.Undo,
+		k.Search,
+		k.FilterByTag,
+		k.ClearSearch,
+		k.NextFocusArea,
+		k.PrevFocusArea,
+		k.ToggleGroups,
+		k.ToggleSubtasks,
+		k.CycleSort,
+		k.ReverseSort,
+		k.MoveUp,
+		k.MoveDown,
+		k.MoveToTop,
+		k.MoveToBottom,
+		k.RaisePriority,
+		k.LowerPriority,
+		k.NextView,
+		k.SaveView,
+		k.ScrollDetailDown,
+		k.ScrollDetailUp,
+		k.NextChecklistItem,
+		k.PrevChecklistItem,
+		k.ToggleChecklistItem,
+	}
+}
+
+// setDoneMode enables the bindings that apply to resolved tasks instead of
+// those for open tasks.
+func (k *keyMap) setDoneMode(done bool) {
+	bindings := []*key.Binding{
+		&k.Edit, &k.Delete, &k.Resolve, &k.Move, &k.Snooze, &k.ToggleSnoozed, &k.ToggleChecklistItem, &k.FocusTimer, &k.TrackTime,
+		&k.MoveUp, &k.MoveDown, &k.MoveToTop, &k.MoveToBottom, &k.RaisePriority, &k.LowerPriority,
+	}
+
+	for _, binding := range bindings {
+		binding.SetEnabled(!done)
+	}
+
+	k.Reopen.SetEnabled(done)
+
+	if done {
+		k.ToggleDone.SetHelp("D", "open tasks")
+	} else {
+		k.ToggleDone.SetHelp("D", "done tasks")
 	}
 }
@@ -7,52 +7,156 @@ import (
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	soqapi "github.com/mole-squad/soq-api/api"
 	"github.com/mole-squad/soq-tui/pkg/api"
 	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/confirm"
 	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/markdown"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/sidepanelview"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/subtasks"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+	"github.com/mole-squad/soq-tui/pkg/undo"
 )
 
 type Model struct {
 	client  *api.Client
 	logger  *logger.Logger
+	store   *store.Store
 	tasks   []soqapi.TaskDTO
+	meta    map[uint]store.TaskMeta
 	keys    keyMap
 	teaList list.Model
+
+	resolved    []store.ResolvedTask
+	showingDone bool
+	sessions    map[uint]int
+	tracked     map[uint]time.Duration
+	trackingID  uint
+
+	focusAreas       []soqapi.FocusAreaDTO
+	areaMeta         map[uint]store.FocusAreaMeta
+	focusAreaFilter  uint
+	groupByFocusArea bool
+	showSubtasks     bool
+	sortOrder        taskquery.Sort
+	taskOrder        []uint
+	filterBar        filterBar
+
+	query       taskquery.Query
+	queryErr    error
+	searching   bool
+	searchInput textinput.Model
+
+	marked     map[uint]bool
+	markAnchor int
+
+	bulkRunning bool
+	bulkReport  []string
+	moving      bool
+	moveIdx     int
+
+	snoozing        bool
+	snoozeIdx       int
+	snoozeDateInput textinput.Model
+	snoozeErr       error
+	showSnoozed     bool
+	wakeGen         int
+
+	confirm     confirm.Model
+	pendingBulk bulkAction
+	undo        undo.Stack
+
+	activeView    string
+	namingView    bool
+	viewNameInput textinput.Model
+
+	panelView    sidepanelview.Model
+	detail       viewport.Model
+	detailTaskID uint
+	checklist    markdown.ChecklistCursor
+
+	delegate mouse.ListDelegate
+	helpBar  mouse.HelpBar
+	clicks   mouse.ClickTracker
+
+	height int
+	width  int
 }
 
-func New(logger *logger.Logger, client *api.Client) common.AppView {
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
 	listKeys := newKeyMap()
 
-	teaList := list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+	delegate := mouse.NewListDelegate(newTaskDelegate())
+
+	teaList := list.New([]list.Item{}, delegate, 0, 0)
 	teaList.Title = "Tasks"
+	teaList.SetShowHelp(false)
+	teaList.SetStatusBarItemName("task", "tasks")
 
-	teaList.AdditionalShortHelpKeys = func() []key.Binding {
-		return []key.Binding{
-			listKeys.New,
-			listKeys.Edit,
-			listKeys.Delete,
-			listKeys.Resolve,
-		}
+	// The search bar replaces the list's fuzzy filter.
+	teaList.SetFilteringEnabled(false)
+
+	// g toggles grouping, so only home jumps to the top.
+	teaList.KeyMap.GoToStart.SetKeys("home")
+
+	teaList.AdditionalShortHelpKeys = listKeys.shortHelp
+	teaList.AdditionalFullHelpKeys = listKeys.fullHelp
+
+	detail := viewport.New(0, 0)
+	detail.MouseWheelEnabled = true
+
+	model := Model{
+		client:    client,
+		logger:    logger,
+		store:     store,
+		keys:      listKeys,
+		teaList:   teaList,
+		filterBar: newFilterBar(),
+
+		marked:     make(map[uint]bool),
+		markAnchor: -1,
+		confirm:    confirm.New(),
+
+		searchInput:     newSearchInput(),
+		viewNameInput:   newViewNameInput(),
+		snoozeDateInput: newSnoozeDateInput(),
+
+		panelView: sidepanelview.New(),
+		detail:    detail,
+		delegate:  delegate,
+		helpBar:   mouse.NewHelpBar(),
 	}
 
-	teaList.AdditionalFullHelpKeys = func() []key.Binding {
-		return []key.Binding{
-			listKeys.New,
-			listKeys.Edit,
-			listKeys.Delete,
-			listKeys.Resolve,
-			listKeys.Settings,
-		}
+	prefs, err := store.LoadPrefs()
+	if err != nil {
+		logger.Error("Error loading preferences", "error", err)
+	}
+
+	model.focusAreaFilter = prefs.TaskListFocusAreaID
+	model.groupByFocusArea = prefs.GroupByFocusArea
+	model.showSubtasks = !prefs.HideSubtasks
+	model.showSnoozed = prefs.ShowSnoozed
+	model.activeView = prefs.TaskListView
+
+	if query, err := taskquery.ParseQuery(prefs.TaskListQuery); err == nil {
+		model.query = query
 	}
 
-	return Model{
-		client:  client,
-		logger:  logger,
-		keys:    listKeys,
-		teaList: teaList,
+	if field, err := taskquery.ParseSortField(prefs.TaskListSort); err == nil {
+		model.sortOrder = taskquery.Sort{Field: field, Descending: prefs.TaskListDescending}
 	}
+
+	model.updateTitle()
+
+	return model
 }
 
 func (m Model) Init() tea.Cmd {
@@ -62,20 +166,50 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.teaList.SetSize(msg.Width, msg.Height)
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resize()
 
 	case tea.KeyMsg:
 		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+
+	case bulkDoneMsg:
+		return m.onBulkDone(msg)
+
+	case wakeMsg:
+		return m.onWake(msg)
+	}
+
+	if undo.IsExpireMsg(msg) {
+		m.undo = m.undo.Update(msg)
+		m.resize()
+
+		return m, nil
 	}
 
 	var cmd tea.Cmd
 	m.teaList, cmd = m.teaList.Update(msg)
+	m.syncDetail()
 
 	return m, cmd
 }
 
 func (m Model) View() string {
-	return m.teaList.View()
+	sections := []string{m.filterBar.render(m.focusAreaFilter, m.showingDone, m.width)}
+
+	if searchBar := m.renderSearchBar(); searchBar != "" {
+		sections = append(sections, searchBar)
+	}
+
+	sections = append(sections,
+		m.panelView.Render(m.teaList.View(), m.detail.View()),
+		m.renderHelp(),
+	)
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
 func (m Model) Blur() (tea.Model, tea.Cmd) {
@@ -94,13 +228,233 @@ func (m Model) refreshTasks() (Model, tea.Cmd) {
 
 	m.tasks = tasks
 
-	newItems := make([]list.Item, len(m.tasks))
+	meta, err := m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	m.meta = meta
+
+	taskOrder, err := m.store.LoadTaskOrder()
+	if err != nil {
+		m.logger.Error("Error loading task order", "error", err)
+	}
+
+	m.taskOrder = taskOrder
+
+	resolved, err := m.store.LoadResolvedTasks()
+	if err != nil {
+		m.logger.Error("Error loading resolved tasks", "error", err)
+	}
+
+	m.resolved = resolved
+	m.filterBar.setDoneCount(len(m.resolved))
+
+	sessions, err := m.store.LoadFocusSessions()
+	if err != nil {
+		m.logger.Error("Error loading focus sessions", "error", err)
+	}
+
+	m.sessions = store.CountFocusSessions(sessions)
+
+	timeEntries, err := m.store.LoadTimeEntries()
+	if err != nil {
+		m.logger.Error("Error loading time log", "error", err)
+	}
+
+	m.tracked = store.TrackedByTask(timeEntries, time.Now())
+
+	m.trackingID = 0
+	if active, ok := store.ActiveTimeEntry(timeEntries); ok {
+		m.trackingID = active.TaskID
+	}
+
+	focusAreas, err := m.getFocusAreas()
+	if err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	m.focusAreas = focusAreas
+
+	areaMeta, err := m.store.LoadFocusAreaMeta()
+	if err != nil {
+		m.logger.Error("Error loading focus area metadata", "error", err)
+	}
+
+	m.areaMeta = areaMeta
+	m.filterBar.setFocusAreas(m.focusAreas, m.areaMeta, m.sourceTasks())
+
+	if !m.filterBar.has(m.focusAreaFilter) {
+		m.focusAreaFilter = taskquery.AllFocusAreas
+	}
+
+	cmd := tea.Batch(m.setItems(), m.scheduleWake())
+
+	return m, cmd
+}
+
+// setItems fills the list with the tasks that pass the focus area filter and
+// the search query, under section headers if grouping is enabled. Open tasks
+// are followed by their subtasks unless those are hidden.
+func (m *Model) setItems() tea.Cmd {
+	tasks := taskquery.FilterByFocusArea(m.sourceTasks(), m.focusAreaFilter)
+	tasks = taskquery.FilterTasks(tasks, m.query, m.meta)
+	tasks = taskquery.SortTasks(tasks, m.sortOrder, m.meta, m.taskOrder)
+
+	m.pruneMarks(tasks)
+
+	items := make([]list.Item, 0, len(tasks))
+
+	if m.groupByFocusArea {
+		for _, group := range taskquery.GroupByFocusArea(tasks) {
+			items = append(items, GroupHeaderItem{
+				focusArea: group.FocusArea,
+				meta:      m.areaMeta[group.FocusArea.ID],
+				count:     len(group.Tasks),
+			})
+
+			for _, task := range group.Tasks {
+				items = m.appendTask(items, task)
+			}
+		}
+	} else {
+		for _, task := range tasks {
+			items = m.appendTask(items, task)
+		}
+	}
+
+	// The status bar counts list items, so it is only right without headers
+	// and subtasks.
+	m.teaList.SetShowStatusBar(len(items) == len(tasks))
+	m.updateTitle()
+
+	cmd := m.teaList.SetItems(items)
+	m.skipHeaders(-1)
+	m.syncDetail()
 
-	for i, task := range m.tasks {
-		newItems[i] = TaskListItem{task: task}
+	return cmd
+}
+
+func (m Model) appendTask(items []list.Item, task soqapi.TaskDTO) []list.Item {
+	item := m.newItem(task)
+	items = append(items, item)
+
+	if m.showSubtasks && item.resolvedAt.IsZero() {
+		items = append(items, newSubtaskItems(item.subtasks, m.delegate.Height())...)
 	}
 
-	return m, m.teaList.SetItems(newItems)
+	return items
+}
+
+func (m Model) newItem(task soqapi.TaskDTO) TaskListItem {
+	item := TaskListItem{
+		task:       task,
+		marked:     m.marked[task.ID],
+		resolvedAt: m.resolvedAt(task.ID),
+		priority:   m.meta[task.ID].Priority,
+		tags:       m.meta[task.ID].Tags,
+		sessions:   m.sessions[task.ID],
+		tracked:    m.tracked[task.ID],
+		tracking:   m.trackingID == task.ID,
+		badge:      m.focusAreaBadge(task.FocusArea),
+		subtasks:   subtasks.Parse(task.Notes),
+	}
+
+	if item.resolvedAt.IsZero() {
+		item.due = m.meta[task.ID].Due
+		item.dueBadge = renderDueBadge(item.due, time.Now())
+		item.repeats = m.meta[task.ID].Recurrence
+		item.snoozeBadge = renderSnoozeBadge(m.meta[task.ID], time.Now())
+	}
+
+	return item
+}
+
+func (m Model) focusAreaBadge(focusArea soqapi.FocusAreaDTO) string {
+	meta := m.areaMeta[focusArea.ID]
+
+	return styles.FocusAreaBadge(focusArea.Name, meta.Glyph, meta.Color)
+}
+
+// skipHeaders moves the cursor off group headers and subtasks, continuing in
+// the direction it was moving from prevIdx. If there is no task that way, it
+// turns back.
+func (m *Model) skipHeaders(prevIdx int) {
+	if _, ok := m.teaList.SelectedItem().(TaskListItem); ok || len(m.teaList.VisibleItems()) == 0 {
+		return
+	}
+
+	step := -1
+	if idx := m.teaList.Index(); idx > prevIdx || idx == 0 {
+		step = 1
+	}
+
+	if !m.selectNextTask(step) {
+		m.selectNextTask(-step)
+	}
+}
+
+// selectNextTask selects the nearest task from the cursor in the direction of
+// step, and reports whether there was one.
+func (m *Model) selectNextTask(step int) bool {
+	items := m.teaList.VisibleItems()
+
+	for idx := m.teaList.Index() + step; idx >= 0 && idx < len(items); idx += step {
+		if _, ok := items[idx].(TaskListItem); ok {
+			m.teaList.Select(idx)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m Model) setFocusAreaFilter(focusAreaID uint) (Model, tea.Cmd) {
+	m.focusAreaFilter = focusAreaID
+
+	return m.applyQueryChange()
+}
+
+func (m Model) toggleSubtasks() (Model, tea.Cmd) {
+	m.showSubtasks = !m.showSubtasks
+	m.savePrefs()
+
+	return m, m.setItems()
+}
+
+func (m Model) toggleGroups() (Model, tea.Cmd) {
+	m.groupByFocusArea = !m.groupByFocusArea
+
+	return m.applyQueryChange()
+}
+
+func (m Model) savePrefs() {
+	err := m.store.UpdatePrefs(func(prefs *store.Prefs) {
+		prefs.TaskListFocusAreaID = m.focusAreaFilter
+		prefs.GroupByFocusArea = m.groupByFocusArea
+		prefs.HideSubtasks = !m.showSubtasks
+		prefs.ShowSnoozed = m.showSnoozed
+		prefs.TaskListSort = string(m.sortOrder.Field)
+		prefs.TaskListDescending = m.sortOrder.Descending
+		prefs.TaskListQuery = m.query.String()
+		prefs.TaskListView = m.activeView
+	})
+
+	if err != nil {
+		m.logger.Error("Error saving preferences", "error", err)
+	}
+}
+
+func (m Model) getFocusAreas() ([]soqapi.FocusAreaDTO, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	focusAreas, err := m.client.ListFocusAreas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load focus areas: %w", err)
+	}
+
+	return focusAreas, nil
 }
 
 func (m Model) getTasks() ([]soqapi.TaskDTO, error) {
@@ -116,6 +470,33 @@ func (m Model) getTasks() ([]soqapi.TaskDTO, error) {
 }
 
 func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.namingView {
+		return m.onViewNameKeyMsg(msg)
+	}
+
+	if m.searching {
+		return m.onSearchKeyMsg(msg)
+	}
+
+	if m.moving {
+		return m.onMoveKeyMsg(msg)
+	}
+
+	if m.snoozing {
+		return m.onSnoozeKeyMsg(msg)
+	}
+
+	if m.confirm.IsOpen() {
+		return m.onConfirmMsg(msg)
+	}
+
+	if len(m.bulkReport) > 0 {
+		m.bulkReport = nil
+		m.resize()
+
+		return m, nil
+	}
+
 	switch {
 	case key.Matches(msg, m.keys.New):
 		return m, tea.Sequence(
@@ -127,21 +508,275 @@ func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m.onEditTask()
 
 	case key.Matches(msg, m.keys.Delete):
-		return m.onDeleteTask()
+		return m.requestBulk(bulkDelete)
 
 	case key.Matches(msg, m.keys.Resolve):
-		return m.onResolveTask()
+		return m.requestBulk(bulkResolve)
+
+	case key.Matches(msg, m.keys.Reopen):
+		return m.startBulk(bulkReopen, soqapi.FocusAreaDTO{})
+
+	case key.Matches(msg, m.keys.ToggleDone):
+		return m.toggleDone()
+
+	case key.Matches(msg, m.keys.Undo):
+		return m.onUndo()
+
+	case key.Matches(msg, m.keys.Move):
+		return m.startMove()
+
+	case key.Matches(msg, m.keys.Snooze):
+		return m.startSnooze()
+
+	case key.Matches(msg, m.keys.ToggleSnoozed):
+		return m.toggleSnoozed()
+
+	case key.Matches(msg, m.keys.ToggleMark):
+		m.toggleMark()
+		return m, m.setItems()
+
+	case key.Matches(msg, m.keys.MarkRange):
+		m.markRange()
+		return m, m.setItems()
+
+	case key.Matches(msg, m.keys.MarkAll):
+		m.markAllVisible()
+		return m, m.setItems()
 
 	case key.Matches(msg, m.keys.Settings):
 		return m, common.AppStateCmd(common.AppStateSettings)
+
+	case key.Matches(msg, m.keys.Board):
+		return m, common.AppStateCmd(common.AppStateBoard)
+
+	case key.Matches(msg, m.keys.FocusTimer):
+		return m.onFocusTimer()
+
+	case key.Matches(msg, m.keys.TrackTime):
+		return m.onTrackTime()
+
+	case key.Matches(msg, m.keys.TimeLog):
+		return m, common.AppStateCmd(common.AppStateTimeLog)
+
+	case key.Matches(msg, m.keys.Agenda):
+		return m, common.AppStateCmd(common.AppStateAgenda)
+
+	case key.Matches(msg, m.keys.Review):
+		return m, common.AppStateCmd(common.AppStateReview)
+
+	case key.Matches(msg, m.keys.ScrollDetailDown):
+		m.detail.LineDown(1)
+		return m, nil
+
+	case key.Matches(msg, m.keys.ScrollDetailUp):
+		m.detail.LineUp(1)
+		return m, nil
+
+	case key.Matches(msg, m.keys.NextChecklistItem):
+		m.checklist.Next(m.selectedNotes())
+		m.syncDetail()
+
+		return m, nil
+
+	case key.Matches(msg, m.keys.PrevChecklistItem):
+		m.checklist.Prev(m.selectedNotes())
+		m.syncDetail()
+
+		return m, nil
+
+	case key.Matches(msg, m.keys.ToggleChecklistItem):
+		return m.onToggleChecklistItem()
+
+	case key.Matches(msg, m.keys.Search):
+		return m.startSearch()
+
+	case key.Matches(msg, m.keys.FilterByTag):
+		return m.filterByTag()
+
+	case key.Matches(msg, m.keys.ClearSearch) && !m.query.IsEmpty():
+		return m.setQuery(taskquery.Query{})
+
+	case key.Matches(msg, m.keys.ClearMarks) && len(m.marked) > 0:
+		m.clearMarks()
+		return m, m.setItems()
+
+	case key.Matches(msg, m.keys.NextFocusArea):
+		return m.setFocusAreaFilter(m.filterBar.cycle(m.focusAreaFilter, 1))
+
+	case key.Matches(msg, m.keys.PrevFocusArea):
+		return m.setFocusAreaFilter(m.filterBar.cycle(m.focusAreaFilter, -1))
+
+	case key.Matches(msg, m.keys.ToggleGroups):
+		return m.toggleGroups()
+
+	case key.Matches(msg, m.keys.ToggleSubtasks):
+		return m.toggleSubtasks()
+
+	case key.Matches(msg, m.keys.CycleSort):
+		return m.cycleSortField()
+
+	case key.Matches(msg, m.keys.ReverseSort):
+		return m.toggleSortDirection()
+
+	case key.Matches(msg, m.keys.MoveUp):
+		return m.reorderTask(moveUp)
+
+	case key.Matches(msg, m.keys.MoveDown):
+		return m.reorderTask(moveDown)
+
+	case key.Matches(msg, m.keys.MoveToTop):
+		return m.reorderTask(moveToTop)
+
+	case key.Matches(msg, m.keys.MoveToBottom):
+		return m.reorderTask(moveToBottom)
+
+	case key.Matches(msg, m.keys.RaisePriority):
+		return m.changePriority(1)
+
+	case key.Matches(msg, m.keys.LowerPriority):
+		return m.changePriority(-1)
+
+	case key.Matches(msg, m.keys.NextView):
+		return m.cycleView()
+
+	case key.Matches(msg, m.keys.SaveView):
+		return m.startSavingView()
 	}
 
+	prevIdx := m.teaList.Index()
+
 	var cmd tea.Cmd
 	m.teaList, cmd = m.teaList.Update(msg)
+	m.skipHeaders(prevIdx)
+	m.resize()
 
 	return m, cmd
 }
 
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if m.confirm.IsOpen() {
+		return m.onConfirmMsg(msg)
+	}
+
+	if keyMsg, ok := m.helpBar.Clicked(m.teaList, msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	if focusAreaID, ok := m.filterBar.clicked(msg); ok {
+		return m.setFocusAreaFilter(focusAreaID)
+	}
+
+	if m.filterBar.doneClicked(msg) {
+		return m.toggleDone()
+	}
+
+	if m.panelView.InPanel(msg) {
+		var cmd tea.Cmd
+		m.detail, cmd = m.detail.Update(msg)
+
+		return m, cmd
+	}
+
+	prevIdx := m.teaList.Index()
+
+	idx := m.delegate.HandleMouse(&m.teaList, msg)
+	m.skipHeaders(prevIdx)
+	m.syncDetail()
+
+	if idx >= 0 && idx == m.teaList.Index() && m.clicks.Click(fmt.Sprint(idx)) && !m.showingDone {
+		return m.onEditTask()
+	}
+
+	return m, nil
+}
+
+// resize lays out the list and the detail pane, hiding the pane when the
+// terminal is too narrow to fit both.
+func (m *Model) resize() {
+	availHeight := m.height -
+		lipgloss.Height(m.renderHelp()) -
+		lipgloss.Height(m.filterBar.render(m.focusAreaFilter, m.showingDone, m.width))
+
+	if searchBar := m.renderSearchBar(); searchBar != "" {
+		availHeight -= lipgloss.Height(searchBar)
+	}
+
+	m.panelView.SetIsOpen(m.width >= minDetailWidth)
+	m.panelView.SetPanelWidth(max(minPanelWidth, m.width*2/5))
+	m.panelView, _ = m.panelView.Update(tea.WindowSizeMsg{Width: m.width, Height: availHeight})
+
+	m.teaList.SetSize(m.panelView.GetContentWidth(), availHeight)
+	m.teaList.Help.Width = m.width
+
+	m.detail.Width, m.detail.Height = m.panelView.GetPanelContentSize()
+	m.syncDetail()
+}
+
+// syncDetail shows the selected task in the detail pane, scrolling back to the
+// top whenever the selection changes.
+func (m *Model) syncDetail() {
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok {
+		m.detail.SetContent("")
+		m.detailTaskID = 0
+
+		return
+	}
+
+	if taskItem.task.ID != m.detailTaskID {
+		m.detail.GotoTop()
+		m.detailTaskID = taskItem.task.ID
+		m.checklist.Reset()
+	}
+
+	m.detail.SetContent(renderTaskDetail(
+		taskItem,
+		m.meta[taskItem.task.ID],
+		m.checklist.Index(),
+		m.detail.Width,
+	))
+}
+
+// selectedNotes returns the notes the checklist cursor walks. Subtasks have
+// their own rows, so their block is left out.
+func (m Model) selectedNotes() string {
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok {
+		return ""
+	}
+
+	body, _ := subtasks.Split(taskItem.task.Notes)
+
+	return body
+}
+
+func (m Model) renderHelp() string {
+	switch {
+	case m.namingView:
+		return m.renderViewNamePrompt()
+
+	case m.moving:
+		return m.renderMovePicker()
+
+	case m.snoozing:
+		return m.renderSnoozePicker()
+
+	case m.confirm.IsOpen():
+		return m.teaList.Styles.HelpStyle.Render(m.confirm.View())
+
+	case len(m.bulkReport) > 0:
+		return m.renderBulkReport()
+	}
+
+	help := m.teaList.Styles.HelpStyle.Render(m.helpBar.View(m.teaList.Help, m.teaList))
+
+	if toast := m.undo.View(m.keys.Undo.Help().Key); toast != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, m.teaList.Styles.HelpStyle.Render(toast), help)
+	}
+
+	return help
+}
+
 func (m Model) onEditTask() (Model, tea.Cmd) {
 	selected := m.teaList.SelectedItem()
 	if selected == nil {
@@ -159,40 +794,85 @@ func (m Model) onEditTask() (Model, tea.Cmd) {
 	)
 }
 
-func (m Model) onDeleteTask() (Model, tea.Cmd) {
-	selected := m.teaList.SelectedItem()
-	if selected == nil {
+// onTrackTime starts tracking time on the selected task, or stops tracking if
+// it is the task being tracked.
+func (m Model) onTrackTime() (Model, tea.Cmd) {
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok {
 		return m, common.NewErrorMsg(fmt.Errorf("no task selected"))
 	}
 
-	taskItem, ok := selected.(TaskListItem)
-	if !ok {
-		return m, common.NewErrorMsg(fmt.Errorf("unexpected task item type"))
-	}
+	status := fmt.Sprintf("Tracking time on %q", taskItem.task.Summary)
 
-	err := m.client.DeleteTask(context.Background(), taskItem.task.ID)
-	if err != nil {
-		return m, common.NewErrorMsg(fmt.Errorf("failed to delete task: %w", err))
+	if taskItem.tracking {
+		if _, _, err := m.store.StopTimeEntry(time.Now()); err != nil {
+			return m, common.NewErrorMsg(err)
+		}
+
+		status = fmt.Sprintf("Stopped tracking %q", taskItem.task.Summary)
+	} else if _, err := m.store.StartTimeEntry(taskItem.task, time.Now()); err != nil {
+		return m, common.NewErrorMsg(err)
 	}
 
-	return m.refreshTasks()
+	m, refreshCmd := m.refreshTasks()
+
+	return m, tea.Batch(refreshCmd, common.NewTimeLogChangedMsg(), m.teaList.NewStatusMessage(status))
 }
 
-func (m Model) onResolveTask() (Model, tea.Cmd) {
-	selected := m.teaList.SelectedItem()
-	if selected == nil {
+func (m Model) onFocusTimer() (Model, tea.Cmd) {
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok {
 		return m, common.NewErrorMsg(fmt.Errorf("no task selected"))
 	}
 
-	taskItem, ok := selected.(TaskListItem)
-	if !ok {
-		return m, common.NewErrorMsg(fmt.Errorf("unexpected task item type"))
+	return m, tea.Sequence(
+		common.NewFocusTaskMsg(taskItem.task),
+		common.AppStateCmd(common.AppStateFocusTimer),
+	)
+}
+
+// onToggleChecklistItem ticks or unticks the selected checklist item in the
+// notes of the selected task and saves the rewritten notes.
+func (m Model) onToggleChecklistItem() (Model, tea.Cmd) {
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok || m.checklist.Index() < 0 {
+		return m, nil
+	}
+
+	task := taskItem.task
+
+	notes, err := toggleChecklistItem(task.Notes, m.checklist.Index())
+	if err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("failed to toggle checklist item: %w", err))
+	}
+
+	dto := soqapi.UpdateTaskRequestDTO{
+		Summary:     task.Summary,
+		Notes:       notes,
+		FocusAreaID: task.FocusArea.ID,
 	}
 
-	_, err := m.client.ResolveTask(context.Background(), taskItem.task.ID)
+	_, err = m.client.UpdateTask(context.Background(), task.ID, &dto)
 	if err != nil {
-		return m, common.NewErrorMsg(fmt.Errorf("failed to resolve task: %w", err))
+		return m, common.NewErrorMsg(fmt.Errorf("failed to update task: %w", err))
+	}
+
+	if err := m.store.TouchTask(task.ID, false); err != nil {
+		m.logger.Error("Error recording task timestamps", "error", err)
 	}
 
 	return m.refreshTasks()
 }
+
+// toggleChecklistItem ticks or unticks a checklist item of notes, counting
+// only the items outside the subtasks block.
+func toggleChecklistItem(notes string, index int) (string, error) {
+	body, items := subtasks.Split(notes)
+
+	body, err := markdown.ToggleChecklistItem(body, index)
+	if err != nil {
+		return "", err
+	}
+
+	return subtasks.Join(body, items), nil
+}
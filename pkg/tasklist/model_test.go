@@ -0,0 +1,45 @@
+package tasklist
+
+import "testing"
+
+func TestToggleChecklistItem(t *testing.T) {
+	notes := "- [ ] Book venue\n\n<!-- subtasks -->\n- [ ] Draft outline\n- [x] Review with team\n<!-- /subtasks -->"
+
+	tests := []struct {
+		name  string
+		notes string
+		index int
+		want  string
+	}{
+		{
+			name:  "item before the subtasks block",
+			notes: notes,
+			index: 0,
+			want:  "- [x] Book venue\n\n<!-- subtasks -->\n- [ ] Draft outline\n- [x] Review with team\n<!-- /subtasks -->",
+		},
+		{
+			name:  "notes without subtasks",
+			notes: "Steps:\n- [x] one\n- [ ] two",
+			index: 1,
+			want:  "Steps:\n- [x] one\n- [x] two",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toggleChecklistItem(tt.notes, tt.index)
+			if err != nil {
+				t.Fatalf("toggleChecklistItem returned error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("toggleChecklistItem = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Subtasks are not checklist items of the detail pane.
+	if _, err := toggleChecklistItem(notes, 1); err == nil {
+		t.Errorf("toggleChecklistItem(notes, 1) toggled a subtask, want an error")
+	}
+}
@@ -0,0 +1,187 @@
+package tasklist
+
+import (
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+type reorderMove int
+
+const (
+	moveUp reorderMove = iota
+	moveDown
+	moveToTop
+	moveToBottom
+)
+
+var manualSort = taskquery.Sort{Field: taskquery.SortManual}
+
+// reorderTask moves the selected task among its neighbours in the list. The
+// first move switches to the manual order, starting from the current one so
+// the list does not jump.
+func (m Model) reorderTask(move reorderMove) (Model, tea.Cmd) {
+	if m.showingDone {
+		return m, nil
+	}
+
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok {
+		return m, nil
+	}
+
+	var statusCmd tea.Cmd
+
+	if m.sortOrder != manualSort {
+		m.taskOrder = taskIDs(taskquery.SortTasks(m.tasks, m.sortOrder, m.meta, m.taskOrder))
+		m.sortOrder = manualSort
+		m.activeView = ""
+		m.savePrefs()
+
+		statusCmd = m.teaList.NewStatusMessage("Sorted manually")
+	}
+
+	order := taskIDs(taskquery.SortTasks(m.tasks, manualSort, m.meta, m.taskOrder))
+
+	peers := m.reorderPeers(taskItem.task)
+	idx := slices.IndexFunc(peers, func(task soqapi.TaskDTO) bool { return task.ID == taskItem.task.ID })
+
+	var (
+		anchor uint
+		after  bool
+	)
+
+	switch move {
+	case moveUp:
+		if idx <= 0 {
+			return m, statusCmd
+		}
+
+		anchor = peers[idx-1].ID
+
+	case moveDown:
+		if idx < 0 || idx >= len(peers)-1 {
+			return m, statusCmd
+		}
+
+		anchor, after = peers[idx+1].ID, true
+
+	case moveToTop:
+		anchor = peers[0].ID
+
+	case moveToBottom:
+		anchor, after = peers[len(peers)-1].ID, true
+	}
+
+	if anchor == taskItem.task.ID {
+		return m, statusCmd
+	}
+
+	order = slices.DeleteFunc(order, func(taskID uint) bool { return taskID == taskItem.task.ID })
+
+	pos := slices.Index(order, anchor)
+	if pos < 0 {
+		return m, statusCmd
+	}
+
+	if after {
+		pos++
+	}
+
+	m.taskOrder = slices.Insert(order, pos, taskItem.task.ID)
+
+	if err := m.store.SaveTaskOrder(m.taskOrder); err != nil {
+		m.logger.Error("Error saving task order", "error", err)
+	}
+
+	cmd := m.setItems()
+	m.selectTask(taskItem.task.ID)
+
+	return m, tea.Batch(cmd, statusCmd)
+}
+
+// reorderPeers returns the listed tasks a task can trade places with, which
+// are those in its group when the list is grouped.
+func (m Model) reorderPeers(task soqapi.TaskDTO) []soqapi.TaskDTO {
+	peers := m.visibleTasks()
+
+	if m.groupByFocusArea {
+		peers = taskquery.FilterByFocusArea(peers, task.FocusArea.ID)
+	}
+
+	return peers
+}
+
+// changePriority raises or lowers the priority of the marked tasks, or the
+// selected one.
+func (m Model) changePriority(delta int) (Model, tea.Cmd) {
+	if m.showingDone {
+		return m, nil
+	}
+
+	targets := m.targetTasks()
+	if len(targets) == 0 {
+		return m, nil
+	}
+
+	var changed store.Priority
+
+	for _, task := range targets {
+		priority := store.Priority(min(max(int(m.meta[task.ID].Priority)+delta, 0), len(store.Priorities)-1))
+
+		err := m.store.UpdateTaskMeta(task.ID, func(meta *store.TaskMeta) {
+			meta.Priority = priority
+		})
+		if err != nil {
+			return m, m.teaList.NewStatusMessage(fmt.Sprintf("Could not save priority: %s", err))
+		}
+
+		changed = priority
+	}
+
+	meta, err := m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	m.meta = meta
+
+	selectedID := targets[0].ID
+	if taskItem, ok := m.teaList.SelectedItem().(TaskListItem); ok {
+		selectedID = taskItem.task.ID
+	}
+
+	cmd := m.setItems()
+	m.selectTask(selectedID)
+
+	if len(targets) > 1 {
+		return m, cmd
+	}
+
+	return m, tea.Batch(cmd, m.teaList.NewStatusMessage("Priority: "+changed.Label()))
+}
+
+// selectTask moves the cursor to the task with the given ID if it is listed.
+func (m *Model) selectTask(taskID uint) {
+	for idx, item := range m.teaList.Items() {
+		if taskItem, ok := item.(TaskListItem); ok && taskItem.task.ID == taskID {
+			m.teaList.Select(idx)
+			m.syncDetail()
+
+			return
+		}
+	}
+}
+
+func taskIDs(tasks []soqapi.TaskDTO) []uint {
+	ids := make([]uint, len(tasks))
+	for idx, task := range tasks {
+		ids[idx] = task.ID
+	}
+
+	return ids
+}
@@ -0,0 +1,31 @@
+package tasklist
+
+import (
+	"context"
+	"fmt"
+)
+
+// deleteNextInstance removes the instance created when a repeating task was
+// resolved, so that undoing the resolve does not leave two copies.
+func (m Model) deleteNextInstance(ctx context.Context, taskID uint) error {
+	history, err := m.store.LoadResolvedTasks()
+	if err != nil {
+		return err
+	}
+
+	for _, resolved := range history {
+		if resolved.Task.ID != taskID || resolved.NextID == 0 {
+			continue
+		}
+
+		if err := m.client.DeleteTask(ctx, resolved.NextID); err != nil {
+			return fmt.Errorf("error deleting the next instance: %w", err)
+		}
+
+		if err := m.store.DeleteTaskMeta(resolved.NextID); err != nil {
+			m.logger.Error("Error deleting task metadata", "error", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package tasklist
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+var searchBarStyle = lipgloss.NewStyle().Padding(0, 0, 1, 2)
+
+func newSearchInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "Search: "
+	input.PromptStyle = styles.InputLabelStyle
+	input.Placeholder = `area:work notes:"deploy" -resolved id:>100`
+
+	return input
+}
+
+func (m Model) startSearch() (Model, tea.Cmd) {
+	m.searching = true
+	m.searchInput.SetValue(m.query.String())
+	m.searchInput.CursorEnd()
+
+	cmd := m.searchInput.Focus()
+	m.resize()
+
+	return m, cmd
+}
+
+// filterByTag searches for a tag of the selected task, moving on to its next
+// tag if one is already searched for. For a task without tags it starts a
+// search to type one into.
+func (m Model) filterByTag() (Model, tea.Cmd) {
+	item, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok || len(item.tags) == 0 {
+		m, cmd := m.startSearch()
+		m.searchInput.SetValue("tag:")
+		m.searchInput.CursorEnd()
+
+		return m, cmd
+	}
+
+	next := item.tags[0]
+	if idx := slices.Index(item.tags, strings.TrimPrefix(m.query.String(), "tag:")); idx >= 0 {
+		next = item.tags[(idx+1)%len(item.tags)]
+	}
+
+	query, err := taskquery.ParseQuery("tag:" + next)
+	if err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	return m.setQuery(query)
+}
+
+// onSearchKeyMsg edits the query, filtering the list as soon as it parses.
+func (m Model) onSearchKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.ApplySearch):
+		if m.queryErr != nil {
+			return m, nil
+		}
+
+		m.searching = false
+		m.searchInput.Blur()
+		m.resize()
+
+		return m, nil
+
+	case key.Matches(msg, m.keys.ClearSearch):
+		m.searching = false
+		m.searchInput.Blur()
+
+		return m.setQuery(taskquery.Query{})
+	}
+
+	var inputCmd tea.Cmd
+	m.searchInput, inputCmd = m.searchInput.Update(msg)
+
+	query, err := taskquery.ParseQuery(m.searchInput.Value())
+
+	m.queryErr = err
+	if err != nil {
+		m.resize()
+		return m, inputCmd
+	}
+
+	m, listCmd := m.setQuery(query)
+
+	return m, tea.Batch(inputCmd, listCmd)
+}
+
+func (m Model) setQuery(query taskquery.Query) (Model, tea.Cmd) {
+	m.query = query
+	m.queryErr = nil
+
+	m, cmd := m.applyQueryChange()
+	m.resize()
+
+	return m, cmd
+}
+
+func (m Model) renderSearchBar() string {
+	if m.searching {
+		hint := styles.InputHelpStyle.Render(taskquery.QuerySyntax)
+		if m.queryErr != nil {
+			hint = styles.InputErrorStyle.Render(m.queryErr.Error())
+		}
+
+		return searchBarStyle.Width(m.width).Render(lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.searchInput.View(),
+			hint,
+		))
+	}
+
+	if m.query.IsEmpty() {
+		return ""
+	}
+
+	return searchBarStyle.Render(
+		styles.InputLabelStyle.Render("Search: ") +
+			m.query.String() +
+			styles.InputHelpStyle.Render("  (/ to edit, esc to clear)"),
+	)
+}
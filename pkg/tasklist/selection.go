@@ -0,0 +1,115 @@
+package tasklist
+
+import (
+	soqapi "github.com/mole-squad/soq-api/api"
+)
+
+// toggleMark marks or unmarks the task under the cursor and makes it the
+// anchor for range selection.
+func (m *Model) toggleMark() {
+	taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+	if !ok {
+		return
+	}
+
+	if m.marked[taskItem.task.ID] {
+		delete(m.marked, taskItem.task.ID)
+	} else {
+		m.marked[taskItem.task.ID] = true
+	}
+
+	m.markAnchor = m.teaList.Index()
+}
+
+// markRange marks every task between the anchor and the cursor.
+func (m *Model) markRange() {
+	cursor := m.teaList.Index()
+
+	anchor := m.markAnchor
+	if anchor < 0 || anchor >= len(m.teaList.Items()) {
+		anchor = cursor
+	}
+
+	from, to := min(anchor, cursor), max(anchor, cursor)
+
+	for _, item := range m.teaList.Items()[from : to+1] {
+		if taskItem, ok := item.(TaskListItem); ok {
+			m.marked[taskItem.task.ID] = true
+		}
+	}
+
+	m.markAnchor = cursor
+}
+
+// markAllVisible marks every task in the list, or unmarks them all if they are
+// already marked.
+func (m *Model) markAllVisible() {
+	tasks := m.visibleTasks()
+
+	allMarked := true
+	for _, task := range tasks {
+		allMarked = allMarked && m.marked[task.ID]
+	}
+
+	for _, task := range tasks {
+		if allMarked {
+			delete(m.marked, task.ID)
+		} else {
+			m.marked[task.ID] = true
+		}
+	}
+}
+
+func (m *Model) clearMarks() {
+	clear(m.marked)
+	m.markAnchor = -1
+}
+
+// pruneMarks drops marks on tasks that are no longer shown.
+func (m *Model) pruneMarks(shown []soqapi.TaskDTO) {
+	visible := make(map[uint]bool, len(shown))
+	for _, task := range shown {
+		visible[task.ID] = true
+	}
+
+	for id := range m.marked {
+		if !visible[id] {
+			delete(m.marked, id)
+		}
+	}
+}
+
+func (m Model) visibleTasks() []soqapi.TaskDTO {
+	tasks := make([]soqapi.TaskDTO, 0, len(m.teaList.Items()))
+
+	for _, item := range m.teaList.Items() {
+		if taskItem, ok := item.(TaskListItem); ok {
+			tasks = append(tasks, taskItem.task)
+		}
+	}
+
+	return tasks
+}
+
+// targetTasks returns the marked tasks in list order, or the task under the
+// cursor when nothing is marked.
+func (m Model) targetTasks() []soqapi.TaskDTO {
+	if len(m.marked) == 0 {
+		taskItem, ok := m.teaList.SelectedItem().(TaskListItem)
+		if !ok {
+			return nil
+		}
+
+		return []soqapi.TaskDTO{taskItem.task}
+	}
+
+	targets := make([]soqapi.TaskDTO, 0, len(m.marked))
+
+	for _, task := range m.visibleTasks() {
+		if m.marked[task.ID] {
+			targets = append(targets, task)
+		}
+	}
+
+	return targets
+}
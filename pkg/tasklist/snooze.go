@@ -0,0 +1,360 @@
+package tasklist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/recurrence"
+	"github.com/mole-squad/soq-tui/pkg/snooze"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+)
+
+var (
+	snoozedStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+	wokenStyle   = lipgloss.NewStyle().Foreground(styles.HotPink).Bold(true)
+)
+
+type snoozeAction int
+
+const (
+	snoozePreset snoozeAction = iota
+	snoozePickDate
+	snoozeWake
+)
+
+// snoozeOption is a choice in the snooze picker. preset is only set for
+// snoozePreset.
+type snoozeOption struct {
+	action snoozeAction
+	preset snooze.Preset
+}
+
+// wakeMsg is sent when the next snooze ends. Only the message of the latest
+// schedule counts, as the snoozes may have changed since.
+type wakeMsg struct {
+	gen int
+}
+
+func newSnoozeDateInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "Snooze until: "
+	input.PromptStyle = styles.InputLabelStyle
+	input.Placeholder = "2026-11-02, fri, 3d or 2w"
+	input.CharLimit = 32
+
+	return input
+}
+
+// snoozeOptions lists the choices in the snooze picker. Waking is offered when
+// a target task is snoozed.
+func (m Model) snoozeOptions() []snoozeOption {
+	options := make([]snoozeOption, 0, len(snooze.Presets)+2)
+	for _, preset := range snooze.Presets {
+		options = append(options, snoozeOption{action: snoozePreset, preset: preset})
+	}
+
+	options = append(options, snoozeOption{action: snoozePickDate})
+
+	now := time.Now()
+	for _, task := range m.targetTasks() {
+		if m.meta[task.ID].IsSnoozed(now) {
+			return append(options, snoozeOption{action: snoozeWake})
+		}
+	}
+
+	return options
+}
+
+func (o snoozeOption) label(now time.Time) string {
+	switch o.action {
+	case snoozePickDate:
+		return "Pick a date…"
+	case snoozeWake:
+		return "Wake now"
+	}
+
+	return o.preset.Label(now)
+}
+
+// parseSnoozeDate reads a custom snooze end: a date (2006-01-02), a weekday
+// (fri) for its next occurrence, or a number of days (3d) or weeks (2w) from
+// today. The snooze ends at the start of that day, which must be after today.
+func parseSnoozeDate(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	today := timetracking.StartOfDay(now)
+
+	until, ok := parseSnoozeDay(value, now)
+	if !ok {
+		return time.Time{}, fmt.Errorf("expected a date (2026-11-02), a weekday (fri) or a number of days (3d) or weeks (2w)")
+	}
+
+	if !until.After(today) {
+		return time.Time{}, fmt.Errorf("pick a day after today")
+	}
+
+	return until, nil
+}
+
+func parseSnoozeDay(value string, now time.Time) (time.Time, bool) {
+	today := timetracking.StartOfDay(now)
+
+	if date, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return date, true
+	}
+
+	if weekday, ok := recurrence.ParseWeekday(value); ok {
+		return today.AddDate(0, 0, (int(weekday)-int(now.Weekday())+6)%7+1), true
+	}
+
+	for suffix, days := range map[string]int{"d": 1, "w": 7} {
+		if count, err := strconv.Atoi(strings.TrimSuffix(value, suffix)); err == nil && strings.HasSuffix(value, suffix) {
+			return today.AddDate(0, 0, count*days), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (m Model) startSnooze() (Model, tea.Cmd) {
+	if len(m.targetTasks()) == 0 {
+		return m, nil
+	}
+
+	m.snoozing = true
+	m.snoozeIdx = 0
+	m.resize()
+
+	return m, nil
+}
+
+func (m Model) onSnoozeKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.snoozeDateInput.Focused() {
+		return m.onSnoozeDateKeyMsg(msg)
+	}
+
+	options := m.snoozeOptions()
+
+	switch {
+	case key.Matches(msg, m.keys.NextSnoozeOption):
+		m.snoozeIdx = (m.snoozeIdx + 1) % len(options)
+
+	case key.Matches(msg, m.keys.PrevSnoozeOption):
+		m.snoozeIdx = (m.snoozeIdx - 1 + len(options)) % len(options)
+
+	case key.Matches(msg, m.keys.ConfirmSnooze):
+		now := time.Now()
+
+		switch option := options[m.snoozeIdx]; option.action {
+		case snoozePickDate:
+			m.snoozeDateInput.SetValue("")
+			m.snoozeErr = nil
+			cmd := m.snoozeDateInput.Focus()
+			m.resize()
+
+			return m, cmd
+
+		case snoozeWake:
+			return m.snooze(nil)
+
+		default:
+			until := option.preset.Until(now)
+			return m.snooze(&until)
+		}
+
+	case key.Matches(msg, m.keys.CancelSnooze):
+		m.snoozing = false
+		m.resize()
+	}
+
+	return m, nil
+}
+
+func (m Model) onSnoozeDateKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.ConfirmSnooze):
+		until, err := parseSnoozeDate(m.snoozeDateInput.Value(), time.Now())
+		if err != nil {
+			m.snoozeErr = err
+			m.resize()
+
+			return m, nil
+		}
+
+		m.snoozeDateInput.Blur()
+
+		return m.snooze(&until)
+
+	case key.Matches(msg, m.keys.CancelSnooze):
+		m.snoozeDateInput.Blur()
+		m.snoozeErr = nil
+		m.resize()
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.snoozeDateInput, cmd = m.snoozeDateInput.Update(msg)
+
+	return m, cmd
+}
+
+// snooze hides the target tasks until the given time, or wakes them when it
+// is nil.
+func (m Model) snooze(until *time.Time) (Model, tea.Cmd) {
+	tasks := m.targetTasks()
+
+	m.snoozing = false
+
+	for _, task := range tasks {
+		err := m.store.UpdateTaskMeta(task.ID, func(meta *store.TaskMeta) {
+			meta.SnoozedUntil = until
+		})
+		if err != nil {
+			m.resize()
+			return m, m.teaList.NewStatusMessage(fmt.Sprintf("Could not snooze %q", task.Summary))
+		}
+
+		delete(m.marked, task.ID)
+	}
+
+	status := fmt.Sprintf("Woke %s", pluralTasks(len(tasks)))
+	if until != nil {
+		status = fmt.Sprintf("Snoozed %s until %s", pluralTasks(len(tasks)), until.Format(snooze.DayFormat))
+	}
+
+	m, refreshCmd := m.reloadTaskMeta()
+	m.resize()
+
+	return m, tea.Batch(refreshCmd, m.teaList.NewStatusMessage(status))
+}
+
+// reloadTaskMeta refreshes the list after a change to the local task metadata
+// only, without fetching the tasks again.
+func (m Model) reloadTaskMeta() (Model, tea.Cmd) {
+	meta, err := m.store.LoadTaskMeta()
+	if err != nil {
+		m.logger.Error("Error loading task metadata", "error", err)
+	}
+
+	m.meta = meta
+	m.filterBar.setFocusAreas(m.focusAreas, m.areaMeta, m.sourceTasks())
+
+	cmd := tea.Batch(m.setItems(), m.scheduleWake())
+
+	return m, cmd
+}
+
+func (m Model) toggleSnoozed() (Model, tea.Cmd) {
+	m.showSnoozed = !m.showSnoozed
+	m.savePrefs()
+
+	m.filterBar.setFocusAreas(m.focusAreas, m.areaMeta, m.sourceTasks())
+
+	cmd := m.setItems()
+
+	return m, cmd
+}
+
+// scheduleWake sets a timer for the next snooze to end, replacing any timer
+// set before.
+func (m *Model) scheduleWake() tea.Cmd {
+	m.wakeGen++
+
+	now := time.Now()
+
+	var next time.Time
+	for _, task := range m.tasks {
+		if meta := m.meta[task.ID]; meta.IsSnoozed(now) && (next.IsZero() || meta.SnoozedUntil.Before(next)) {
+			next = *meta.SnoozedUntil
+		}
+	}
+
+	if next.IsZero() {
+		return nil
+	}
+
+	gen := m.wakeGen
+
+	return tea.Tick(next.Sub(now), func(time.Time) tea.Msg {
+		return wakeMsg{gen: gen}
+	})
+}
+
+// onWake brings back the tasks whose snooze ended.
+func (m Model) onWake(msg wakeMsg) (Model, tea.Cmd) {
+	if msg.gen != m.wakeGen {
+		return m, nil
+	}
+
+	m.filterBar.setFocusAreas(m.focusAreas, m.areaMeta, m.sourceTasks())
+
+	cmd := tea.Batch(m.setItems(), m.scheduleWake())
+
+	return m, cmd
+}
+
+// snoozedCount counts the open tasks hidden by a snooze.
+func (m Model) snoozedCount() int {
+	count := 0
+	now := time.Now()
+
+	for _, task := range m.tasks {
+		if m.meta[task.ID].IsSnoozed(now) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// renderSnoozeBadge marks snoozed tasks, shown when snoozed tasks are not
+// hidden, and tasks that came back from a snooze today.
+func renderSnoozeBadge(meta store.TaskMeta, now time.Time) string {
+	switch {
+	case meta.IsSnoozed(now):
+		return snoozedStyle.Render("☾ until " + meta.SnoozedUntil.Format(snooze.DayFormat))
+	case meta.IsWoken(now):
+		return wokenStyle.Render("☀ back from snooze")
+	}
+
+	return ""
+}
+
+func (m Model) renderSnoozePicker() string {
+	if m.snoozeDateInput.Focused() {
+		hint := styles.InputHelpStyle.Render("enter snooze • esc back")
+		if m.snoozeErr != nil {
+			hint = styles.InputErrorStyle.Render(m.snoozeErr.Error())
+		}
+
+		return bulkReportStyle.Render(lipgloss.JoinVertical(lipgloss.Left, m.snoozeDateInput.View(), hint))
+	}
+
+	now := time.Now()
+	options := m.snoozeOptions()
+	tabs := make([]string, len(options))
+
+	for idx, option := range options {
+		style := inactiveTabStyle
+		if idx == m.snoozeIdx {
+			style = activeTabStyle
+		}
+
+		tabs[idx] = style.Render(option.label(now))
+	}
+
+	return bulkReportStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.InputLabelStyle.Render(fmt.Sprintf("Snooze %s until:", pluralTasks(len(m.targetTasks())))),
+		strings.Join(tabs, " "),
+		styles.InputHelpStyle.Render("←/→ choose • enter snooze • esc cancel"),
+	))
+}
@@ -0,0 +1,108 @@
+package tasklist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSnoozeDate(t *testing.T) {
+	// A Wednesday afternoon.
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2026-11-02", day(time.November, 2)},
+		{"thu", day(time.October, 22)},
+		{"fri", day(time.October, 23)},
+		{"Monday", day(time.October, 26)},
+		{"tues", day(time.October, 27)},
+		// The same weekday as today means next week.
+		{"wed", day(time.October, 28)},
+		{"1d", day(time.October, 22)},
+		{"3d", day(time.October, 24)},
+		{"1w", day(time.October, 28)},
+		{"2w", day(time.November, 4)},
+		{" 2W ", day(time.November, 4)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSnoozeDate(tt.value, now)
+			if err != nil {
+				t.Fatalf("parseSnoozeDate(%q) returned error: %v", tt.value, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSnoozeDate(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSnoozeDateErrors(t *testing.T) {
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+
+	const (
+		notADay     = "expected a date (2026-11-02), a weekday (fri) or a number of days (3d) or weeks (2w)"
+		notInFuture = "pick a day after today"
+	)
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"0d", notInFuture},
+		{"-1d", notInFuture},
+		{"0w", notInFuture},
+		{"2026-10-21", notInFuture},
+		{"2026-10-01", notInFuture},
+		{"", notADay},
+		{"soon", notADay},
+		{"we", notADay},
+		{"d", notADay},
+		{"3x", notADay},
+		{"2026-13-01", notADay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, err := parseSnoozeDate(tt.value, now)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("parseSnoozeDate(%q) error = %v, want %q", tt.value, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSnoozeDay(t *testing.T) {
+	// A Sunday late in the evening, the end of the week.
+	now := time.Date(2026, time.October, 25, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+		ok    bool
+	}{
+		{"mon", time.Date(2026, time.October, 26, 0, 0, 0, 0, time.UTC), true},
+		{"sun", time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), true},
+		{"0d", time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC), true},
+		{"-1d", time.Date(2026, time.October, 24, 0, 0, 0, 0, time.UTC), true},
+		{"mo", time.Time{}, false},
+		{"next week", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseSnoozeDay(tt.value, now)
+			if ok != tt.ok || !got.Equal(tt.want) {
+				t.Errorf("parseSnoozeDay(%q) = %s, %v, want %s, %v", tt.value, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
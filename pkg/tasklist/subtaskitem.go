@@ -0,0 +1,83 @@
+package tasklist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/subtasks"
+)
+
+// subtaskIndent lines subtasks up under the title of their task.
+const subtaskIndent = priorityColumnWidth + 2
+
+var (
+	subtaskTreeStyle = lipgloss.NewStyle().Foreground(styles.DarkGray)
+	subtaskDoneStyle = lipgloss.NewStyle().
+				Foreground(styles.DarkGray).
+				Strikethrough(true)
+)
+
+// SubtaskItem shows some of the subtasks of the task above it, as many as fit
+// in the height of a task. Like group headers, it cannot be selected.
+type SubtaskItem struct {
+	subtasks []subtasks.Subtask
+
+	// last is set on the item that ends the subtasks of its task.
+	last bool
+}
+
+func (s SubtaskItem) FilterValue() string {
+	return ""
+}
+
+// newSubtaskItems splits the subtasks of a task into list items.
+func newSubtaskItems(items []subtasks.Subtask, perItem int) []list.Item {
+	chunks := make([]list.Item, 0, (len(items)+perItem-1)/perItem)
+
+	for start := 0; start < len(items); start += perItem {
+		end := min(start+perItem, len(items))
+
+		chunks = append(chunks, SubtaskItem{
+			subtasks: items[start:end],
+			last:     end == len(items),
+		})
+	}
+
+	return chunks
+}
+
+func (d taskDelegate) renderSubtasks(w io.Writer, m list.Model, item SubtaskItem) {
+	lines := make([]string, len(item.subtasks))
+	textWidth := max(m.Width()-subtaskIndent-6, 0)
+
+	for idx, subtask := range item.subtasks {
+		branch := "├─"
+		if item.last && idx == len(item.subtasks)-1 {
+			branch = "└─"
+		}
+
+		box, text := "[ ]", lipgloss.NewStyle().MaxWidth(textWidth).Render(subtask.Text)
+		if subtask.Done {
+			box, text = "[x]", subtaskDoneStyle.Render(text)
+		}
+
+		lines[idx] = strings.Repeat(" ", subtaskIndent) + subtaskTreeStyle.Render(branch+box) + " " + text
+	}
+
+	fmt.Fprint(w, strings.Join(lines, "\n"))
+}
+
+// formatProgress renders how many subtasks are done, such as 3/5, or nothing
+// for a task without subtasks.
+func formatProgress(items []subtasks.Subtask) string {
+	done, total := subtasks.Progress(items)
+	if total == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%d", done, total)
+}
@@ -1,19 +1,81 @@
 package tasklist
 
 import (
+	"time"
+
 	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/subtasks"
 )
 
 type TaskListItem struct {
-	task soqapi.TaskDTO
+	task   soqapi.TaskDTO
+	marked bool
+
+	// resolvedAt is set for tasks in the done list.
+	resolvedAt time.Time
+
+	priority store.Priority
+	due      *store.DueDate
+	repeats  string
+	tags     []string
+
+	// subtasks are kept in a block at the end of the task notes.
+	subtasks []subtasks.Subtask
+
+	// sessions counts the completed focus timer sessions on the task.
+	sessions int
+
+	// tracked is the time tracked on the task, and tracking whether its timer
+	// is running.
+	tracked  time.Duration
+	tracking bool
+
+	// badge is the rendered focus area of the task, dueBadge its due date and
+	// snoozeBadge its snooze.
+	badge       string
+	dueBadge    string
+	snoozeBadge string
 }
 
 func (t TaskListItem) Title() string {
+	if t.marked {
+		return "● " + t.task.Summary
+	}
+
 	return t.task.Summary
 }
 
 func (t TaskListItem) Description() string {
-	return ""
+	// The badge carries its own colors, so it goes last to keep the rest of
+	// the line in the delegate's style.
+	if t.resolvedAt.IsZero() {
+		description := t.badge
+		if progress := formatProgress(t.subtasks); progress != "" {
+			description = progress + " " + description
+		}
+
+		if t.repeats != "" {
+			description = repeatMarker + " " + description
+		}
+
+		if t.dueBadge != "" {
+			description += " " + t.dueBadge
+		}
+
+		if t.snoozeBadge != "" {
+			description += " " + t.snoozeBadge
+		}
+
+		for _, tag := range t.tags {
+			description += " " + styles.TagChip(tag)
+		}
+
+		return description
+	}
+
+	return "Resolved " + t.resolvedAt.Local().Format(timestampFormat) + " · " + t.badge
 }
 
 func (t TaskListItem) FilterValue() string {
@@ -0,0 +1,152 @@
+package tasklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/bulk"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/confirm"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/taskops"
+	"github.com/mole-squad/soq-tui/pkg/undo"
+)
+
+// requestBulk runs a resolve or delete, asking first if the action is set to
+// need confirmation.
+func (m Model) requestBulk(action bulkAction) (Model, tea.Cmd) {
+	tasks := m.targetTasks()
+	if len(tasks) == 0 || m.bulkRunning {
+		return m, nil
+	}
+
+	prefs, err := m.store.LoadPrefs()
+	if err != nil {
+		m.logger.Error("Error loading preferences", "error", err)
+	}
+
+	if !prefs.ShouldConfirm(action.confirmAction()) {
+		return m.startBulk(action, soqapi.FocusAreaDTO{})
+	}
+
+	verb := "Resolve"
+	if action == bulkDelete {
+		verb = "Delete"
+	}
+
+	question := fmt.Sprintf("%s %s?", verb, pluralTasks(len(tasks)))
+	if len(tasks) == 1 {
+		question = fmt.Sprintf("%s %q?", verb, tasks[0].Summary)
+	}
+
+	m.pendingBulk = action
+	m.confirm = m.confirm.Ask(question)
+	m.resize()
+
+	return m, nil
+}
+
+func (m Model) onConfirmMsg(msg tea.Msg) (Model, tea.Cmd) {
+	var answer confirm.Answer
+	m.confirm, answer = m.confirm.Update(msg)
+
+	if answer == confirm.Unanswered {
+		return m, nil
+	}
+
+	m.resize()
+
+	if answer == confirm.Denied {
+		return m, nil
+	}
+
+	return m.startBulk(m.pendingBulk, soqapi.FocusAreaDTO{})
+}
+
+// pushUndo records how to reverse the tasks that a bulk action changed.
+func (m Model) pushUndo(action bulkAction, tasks []soqapi.TaskDTO) (Model, tea.Cmd) {
+	if len(tasks) == 0 || action == bulkReopen {
+		return m, nil
+	}
+
+	_, past := action.verbs()
+
+	message := fmt.Sprintf("%s %s", pluralTasks(len(tasks)), past)
+	if len(tasks) == 1 {
+		message = "Task " + past
+	}
+
+	meta := make(map[uint]store.TaskMeta, len(tasks))
+	for _, task := range tasks {
+		meta[task.ID] = m.meta[task.ID]
+	}
+
+	undoTask := func(ctx context.Context, task soqapi.TaskDTO) error {
+		if action == bulkMove {
+			return taskops.Move(ctx, m.client, m.store, m.logger, task, task.FocusArea.ID)
+		}
+
+		if action == bulkResolve {
+			if err := m.deleteNextInstance(ctx, task.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := taskops.Recreate(ctx, m.client, m.store, m.logger, task, task.FocusArea.ID, meta[task.ID]); err != nil {
+			return err
+		}
+
+		if action == bulkResolve {
+			if err := m.store.ForgetResolvedTask(task.ID); err != nil {
+				m.logger.Error("Error updating resolved tasks", "error", err)
+			}
+		}
+
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.undo, cmd = m.undo.Push(message, func(ctx context.Context) error {
+		results := bulk.Run(ctx, tasks, bulk.DefaultLimit, undoTask)
+
+		errs := make([]error, 0)
+		for _, result := range bulk.Failed(results) {
+			errs = append(errs, fmt.Errorf("#%d %s: %w", result.Item.ID, result.Item.Summary, result.Err))
+		}
+
+		return errors.Join(errs...)
+	})
+
+	m.resize()
+
+	return m, cmd
+}
+
+func (m Model) onUndo() (Model, tea.Cmd) {
+	var (
+		undoFunc undo.Func
+		ok       bool
+	)
+
+	m.undo, undoFunc, ok = m.undo.Pop()
+	if !ok {
+		return m, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultRequestTimeout)
+	defer cancel()
+
+	status := "Undone"
+	if err := undoFunc(ctx); err != nil {
+		m.logger.Error("Error undoing action", "error", err)
+		status = fmt.Sprintf("Undo failed: %s", err)
+	}
+
+	m, refreshCmd := m.refreshTasks()
+	m.resize()
+
+	return m, tea.Batch(refreshCmd, m.teaList.NewStatusMessage(status))
+}
@@ -0,0 +1,177 @@
+package tasklist
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/taskquery"
+)
+
+func newViewNameInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "Save view as: "
+	input.PromptStyle = styles.InputLabelStyle
+	input.CharLimit = 64
+
+	return input
+}
+
+func (m Model) cycleSortField() (Model, tea.Cmd) {
+	m.sortOrder.Field = m.sortOrder.Field.Next()
+
+	return m.applyQueryChange()
+}
+
+func (m Model) toggleSortDirection() (Model, tea.Cmd) {
+	m.sortOrder.Descending = !m.sortOrder.Descending
+
+	return m.applyQueryChange()
+}
+
+// applyQueryChange refreshes the list after the filter, sort or grouping was
+// changed by hand, which means a saved view no longer describes it.
+func (m Model) applyQueryChange() (Model, tea.Cmd) {
+	m.activeView = ""
+	m.teaList.ResetSelected()
+
+	m.savePrefs()
+
+	cmd := m.setItems()
+
+	return m, cmd
+}
+
+// cycleView applies the saved view after the active one.
+func (m Model) cycleView() (Model, tea.Cmd) {
+	views, err := m.store.LoadViews()
+	if err != nil {
+		m.logger.Error("Error loading views", "error", err)
+		return m, m.teaList.NewStatusMessage("Could not load saved views")
+	}
+
+	if len(views) == 0 {
+		return m, m.teaList.NewStatusMessage("No saved views, press w to save one")
+	}
+
+	next := 0
+	for idx, view := range views {
+		if strings.EqualFold(view.Name, m.activeView) {
+			next = (idx + 1) % len(views)
+		}
+	}
+
+	view, err := taskquery.ResolveView(views[next], m.focusAreas)
+	if err != nil {
+		return m, m.teaList.NewStatusMessage(err.Error())
+	}
+
+	m.activeView = view.Name
+	m.focusAreaFilter = view.FocusAreaID
+	m.query = view.Query
+	m.sortOrder = view.Sort
+	m.groupByFocusArea = view.Group
+	m.teaList.ResetSelected()
+
+	m.savePrefs()
+
+	cmd := m.setItems()
+	m.resize()
+
+	return m, cmd
+}
+
+func (m Model) startSavingView() (Model, tea.Cmd) {
+	m.namingView = true
+	m.viewNameInput.SetValue(m.activeView)
+	m.viewNameInput.CursorEnd()
+
+	return m, m.viewNameInput.Focus()
+}
+
+func (m Model) onViewNameKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.ConfirmViewName):
+		return m.saveView()
+
+	case key.Matches(msg, m.keys.CancelViewName):
+		m.namingView = false
+		m.viewNameInput.Blur()
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewNameInput, cmd = m.viewNameInput.Update(msg)
+
+	return m, cmd
+}
+
+func (m Model) saveView() (Model, tea.Cmd) {
+	name := strings.TrimSpace(m.viewNameInput.Value())
+	if name == "" {
+		return m, nil
+	}
+
+	view := store.SavedView{
+		Name:       name,
+		Query:      m.query.String(),
+		Sort:       string(m.sortOrder.Field),
+		Descending: m.sortOrder.Descending,
+		Group:      m.groupByFocusArea,
+	}
+
+	for _, fa := range m.focusAreas {
+		if fa.ID == m.focusAreaFilter {
+			view.FocusArea = fa.Name
+		}
+	}
+
+	m.namingView = false
+	m.viewNameInput.Blur()
+
+	if err := m.store.SaveView(view); err != nil {
+		m.logger.Error("Error saving view", "error", err)
+		return m, m.teaList.NewStatusMessage("Could not save view")
+	}
+
+	m.activeView = name
+	m.savePrefs()
+	m.updateTitle()
+
+	return m, m.teaList.NewStatusMessage(fmt.Sprintf("Saved view %q", name))
+}
+
+func (m *Model) updateTitle() {
+	parts := []string{"Tasks"}
+	if m.showingDone {
+		parts[0] = "Done"
+	}
+
+	if m.activeView != "" {
+		parts = append(parts, m.activeView)
+	}
+
+	if m.sortOrder.Field != taskquery.SortDefault {
+		parts = append(parts, "by "+m.sortOrder.String())
+	}
+
+	if len(m.marked) > 0 {
+		parts = append(parts, fmt.Sprintf("%d selected", len(m.marked)))
+	}
+
+	if count := m.snoozedCount(); count > 0 && !m.showingDone && !m.showSnoozed {
+		parts = append(parts, fmt.Sprintf("%d snoozed", count))
+	}
+
+	m.teaList.Title = strings.Join(parts, " · ")
+}
+
+func (m Model) renderViewNamePrompt() string {
+	return lipgloss.NewStyle().Padding(1, 0, 0, 2).Render(m.viewNameInput.View())
+}
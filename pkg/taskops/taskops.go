@@ -0,0 +1,54 @@
+package taskops
+
+import (
+	"context"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// Move moves a task to another focus area and records when it changed.
+func Move(ctx context.Context, client *api.Client, s *store.Store, logger *logger.Logger, task soqapi.TaskDTO, focusAreaID uint) error {
+	dto := soqapi.UpdateTaskRequestDTO{
+		Summary:     task.Summary,
+		Notes:       task.Notes,
+		FocusAreaID: focusAreaID,
+	}
+
+	if _, err := client.UpdateTask(ctx, task.ID, &dto); err != nil {
+		return err
+	}
+
+	if err := s.TouchTask(task.ID, false); err != nil {
+		logger.Error("Error recording task timestamps", "error", err)
+	}
+
+	return nil
+}
+
+// Recreate brings back a deleted or resolved task in a focus area. The API
+// cannot reopen or restore tasks, so this creates a copy and carries over its
+// local metadata.
+func Recreate(ctx context.Context, client *api.Client, s *store.Store, logger *logger.Logger, task soqapi.TaskDTO, focusAreaID uint, meta store.TaskMeta) error {
+	dto := soqapi.CreateTaskRequestDTO{
+		Summary:     task.Summary,
+		Notes:       task.Notes,
+		FocusAreaID: focusAreaID,
+	}
+
+	created, err := client.CreateTask(ctx, &dto)
+	if err != nil {
+		return err
+	}
+
+	err = s.UpdateTaskMeta(created.ID, func(restored *store.TaskMeta) {
+		*restored = meta
+	})
+	if err != nil {
+		logger.Error("Error restoring task metadata", "error", err)
+	}
+
+	return nil
+}
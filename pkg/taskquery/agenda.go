@@ -0,0 +1,142 @@
+package taskquery
+
+import (
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// AgendaBucket is a section of the agenda.
+type AgendaBucket int
+
+const (
+	BucketOverdue AgendaBucket = iota
+	BucketToday
+	BucketThisWeek
+	BucketLater
+)
+
+// AgendaBuckets lists the agenda sections in the order they are shown.
+var AgendaBuckets = []AgendaBucket{BucketOverdue, BucketToday, BucketThisWeek, BucketLater}
+
+func (b AgendaBucket) String() string {
+	switch b {
+	case BucketOverdue:
+		return "Overdue"
+	case BucketToday:
+		return "Today"
+	case BucketThisWeek:
+		return "This week"
+	}
+
+	return "Later"
+}
+
+// AgendaGroup is the tasks in a section of the agenda, soonest due first.
+type AgendaGroup struct {
+	Bucket AgendaBucket
+	Tasks  []soqapi.TaskDTO
+}
+
+// BucketOf returns the agenda section of a due date. The week ends on Sunday.
+func BucketOf(due store.DueDate, now time.Time) AgendaBucket {
+	if !now.Before(due.Deadline()) {
+		return BucketOverdue
+	}
+
+	year, month, day := now.Date()
+	tomorrow := time.Date(year, month, day+1, 0, 0, 0, 0, now.Location())
+
+	daysToMonday := 7 - (int(now.Weekday())+6)%7
+	nextWeek := time.Date(year, month, day+daysToMonday, 0, 0, 0, 0, now.Location())
+
+	switch at := due.RemindAt(); {
+	case at.Before(tomorrow):
+		return BucketToday
+	case at.Before(nextWeek):
+		return BucketThisWeek
+	}
+
+	return BucketLater
+}
+
+// GroupByDue sorts the tasks with a due date into agenda sections. Tasks
+// without a due date are left out, as are empty sections.
+func GroupByDue(tasks []soqapi.TaskDTO, meta map[uint]store.TaskMeta, now time.Time) []AgendaGroup {
+	due := SortTasks(tasks, Sort{Field: SortDue}, meta, nil)
+
+	groups := make([]AgendaGroup, 0, len(AgendaBuckets))
+
+	for _, task := range due {
+		dueDate := meta[task.ID].Due
+		if dueDate == nil {
+			continue
+		}
+
+		bucket := BucketOf(*dueDate, now)
+
+		if len(groups) == 0 || groups[len(groups)-1].Bucket != bucket {
+			groups = append(groups, AgendaGroup{Bucket: bucket})
+		}
+
+		groups[len(groups)-1].Tasks = append(groups[len(groups)-1].Tasks, task)
+	}
+
+	return groups
+}
+
+// compareDue orders tasks by when they become overdue. Tasks without a due
+// date come last.
+func compareDue(a, b *store.DueDate) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	return a.Deadline().Compare(b.Deadline())
+}
+
+// DueLabel describes a due date relative to now, such as "Due tomorrow 09:30"
+// or "Overdue · due Mon".
+func DueLabel(due store.DueDate, now time.Time) string {
+	at := due.At.In(now.Location())
+
+	label := dayLabel(at, now)
+	if due.HasTime {
+		label += " " + at.Format("15:04")
+	}
+
+	if BucketOf(due, now) == BucketOverdue {
+		return "Overdue · due " + label
+	}
+
+	return "Due " + label
+}
+
+func dayLabel(at, now time.Time) string {
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+
+	atYear, atMonth, atDay := at.Date()
+	days := int(time.Date(atYear, atMonth, atDay, 0, 0, 0, 0, now.Location()).Sub(today).Round(24*time.Hour) / (24 * time.Hour))
+
+	switch {
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	case days == -1:
+		return "yesterday"
+	case days > 1 && days < 7:
+		return at.Format("Mon")
+	case atYear == year:
+		return at.Format("Jan 2")
+	}
+
+	return at.Format("Jan 2 2006")
+}
@@ -0,0 +1,91 @@
+package taskquery
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+)
+
+// AllFocusAreas is the focus area ID that matches every task.
+const AllFocusAreas uint = 0
+
+// Group is a focus area and the tasks that belong to it.
+type Group struct {
+	FocusArea soqapi.FocusAreaDTO
+	Tasks     []soqapi.TaskDTO
+}
+
+// FilterByFocusArea returns the tasks in the given focus area, or every task
+// for AllFocusAreas.
+func FilterByFocusArea(tasks []soqapi.TaskDTO, focusAreaID uint) []soqapi.TaskDTO {
+	if focusAreaID == AllFocusAreas {
+		return tasks
+	}
+
+	filtered := make([]soqapi.TaskDTO, 0, len(tasks))
+
+	for _, task := range tasks {
+		if task.FocusArea.ID == focusAreaID {
+			filtered = append(filtered, task)
+		}
+	}
+
+	return filtered
+}
+
+// CountByFocusArea returns the number of tasks in each focus area.
+func CountByFocusArea(tasks []soqapi.TaskDTO) map[uint]int {
+	counts := make(map[uint]int)
+
+	for _, task := range tasks {
+		counts[task.FocusArea.ID]++
+	}
+
+	return counts
+}
+
+// GroupByFocusArea splits tasks into groups ordered by focus area name. Tasks
+// keep their relative order within a group.
+func GroupByFocusArea(tasks []soqapi.TaskDTO) []Group {
+	groups := make([]Group, 0)
+	groupIdx := make(map[uint]int)
+
+	for _, task := range tasks {
+		idx, ok := groupIdx[task.FocusArea.ID]
+		if !ok {
+			idx = len(groups)
+			groupIdx[task.FocusArea.ID] = idx
+			groups = append(groups, Group{FocusArea: task.FocusArea})
+		}
+
+		groups[idx].Tasks = append(groups[idx].Tasks, task)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return strings.ToLower(groups[i].FocusArea.Name) < strings.ToLower(groups[j].FocusArea.Name)
+	})
+
+	return groups
+}
+
+// FindFocusArea looks up a focus area by ID or case-insensitive name.
+func FindFocusArea(focusAreas []soqapi.FocusAreaDTO, nameOrID string) (soqapi.FocusAreaDTO, error) {
+	if id, err := strconv.ParseUint(nameOrID, 10, 0); err == nil {
+		for _, fa := range focusAreas {
+			if fa.ID == uint(id) {
+				return fa, nil
+			}
+		}
+	}
+
+	for _, fa := range focusAreas {
+		if strings.EqualFold(fa.Name, nameOrID) {
+			return fa, nil
+		}
+	}
+
+	return soqapi.FocusAreaDTO{}, fmt.Errorf("no focus area matches %q", nameOrID)
+}
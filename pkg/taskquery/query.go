@@ -0,0 +1,401 @@
+package taskquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/tags"
+)
+
+// Task statuses as reported by the API.
+const (
+	TaskStatusOpen     = 0
+	TaskStatusResolved = 1
+)
+
+// statusKeywords may be used on their own or after is:. Quote them to search
+// for the word instead.
+var statusKeywords = map[string]int{
+	"open":     TaskStatusOpen,
+	"resolved": TaskStatusResolved,
+}
+
+// QuerySyntax is a short description of the query language for help text.
+const QuerySyntax = `words match summary, notes and focus area; ` +
+	`summary: notes: area: tag: id:>10 open resolved; ` +
+	`"quoted text", -term or NOT term, AND (implied), OR, ( )`
+
+// Query is a parsed task search. The zero value matches every task.
+type Query struct {
+	source string
+	root   queryNode
+}
+
+// ParseError reports where a query could not be parsed. Pos is the 1-based
+// column of the offending input.
+type ParseError struct {
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos, e.Msg)
+}
+
+// ParseQuery parses a task search such as `area:work notes:"deploy" -resolved id:>100`.
+func ParseQuery(source string) (Query, error) {
+	tokens, err := lexQuery(source)
+	if err != nil {
+		return Query{}, err
+	}
+
+	p := &queryParser{tokens: tokens}
+
+	if p.peek().kind == tokenEOF {
+		return Query{source: source}, nil
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return Query{}, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		if tok.kind == tokenRParen {
+			return Query{}, &ParseError{Pos: tok.pos, Msg: `unexpected ")" without a matching "("`}
+		}
+
+		return Query{}, &ParseError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok)}
+	}
+
+	return Query{source: source, root: root}, nil
+}
+
+// String returns the source the query was parsed from.
+func (q Query) String() string {
+	return q.source
+}
+
+// IsEmpty reports whether the query matches every task.
+func (q Query) IsEmpty() bool {
+	return q.root == nil
+}
+
+// Match reports whether a task with its local metadata satisfies the query.
+func (q Query) Match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	if q.root == nil {
+		return true
+	}
+
+	return q.root.match(task, meta)
+}
+
+// FilterTasks returns the tasks that satisfy the query. meta is needed for
+// tag: terms.
+func FilterTasks(tasks []soqapi.TaskDTO, query Query, meta map[uint]store.TaskMeta) []soqapi.TaskDTO {
+	if query.IsEmpty() {
+		return tasks
+	}
+
+	filtered := make([]soqapi.TaskDTO, 0, len(tasks))
+
+	for _, task := range tasks {
+		if query.Match(task, meta[task.ID]) {
+			filtered = append(filtered, task)
+		}
+	}
+
+	return filtered
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenQuoted
+	tokenLParen
+	tokenRParen
+	tokenMinus
+	tokenColon
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenLParen:
+		return `"("`
+	case tokenRParen:
+		return `")"`
+	case tokenMinus:
+		return `"-"`
+	case tokenColon:
+		return `":"`
+	case tokenQuoted:
+		return strconv.Quote(t.value)
+	}
+
+	return fmt.Sprintf("%q", t.value)
+}
+
+func lexQuery(source string) ([]token, error) {
+	var tokens []token
+
+	runes := []rune(source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, pos: pos})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, pos: pos})
+			i++
+
+		case r == ':':
+			tokens = append(tokens, token{kind: tokenColon, pos: pos})
+			i++
+
+		case r == '-' && (i == 0 || !isWordRune(runes[i-1])):
+			tokens = append(tokens, token{kind: tokenMinus, pos: pos})
+			i++
+
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, &ParseError{Pos: pos, Msg: "unterminated quoted text"}
+			}
+
+			tokens = append(tokens, token{kind: tokenQuoted, value: string(runes[i+1 : end]), pos: pos})
+			i = end + 1
+
+		default:
+			end := i
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[i:end]), pos: pos})
+			i = end
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes) + 1}), nil
+}
+
+func isWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune(`():"`, r)
+}
+
+type queryParser struct {
+	tokens []token
+	idx    int
+}
+
+func (p *queryParser) peek() token {
+	return p.tokens[p.idx]
+}
+
+func (p *queryParser) next() token {
+	tok := p.tokens[p.idx]
+	if tok.kind != tokenEOF {
+		p.idx++
+	}
+
+	return tok
+}
+
+func (p *queryParser) peekKeyword(keyword string) bool {
+	tok := p.peek()
+
+	return tok.kind == tokenWord && tok.value == keyword
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("OR") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.peek()
+		if tok.kind == tokenEOF || tok.kind == tokenRParen || p.peekKeyword("OR") {
+			return left, nil
+		}
+
+		if p.peekKeyword("AND") {
+			p.next()
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left, right}
+	}
+}
+
+func (p *queryParser) parseUnary() (queryNode, error) {
+	if p.peek().kind == tokenMinus || p.peekKeyword("NOT") {
+		p.next()
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenLParen:
+		if p.peek().kind == tokenRParen {
+			return nil, &ParseError{Pos: tok.pos, Msg: "empty parentheses"}
+		}
+
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, &ParseError{Pos: tok.pos, Msg: `missing ")" for this "("`}
+		}
+
+		return node, nil
+
+	case tokenQuoted:
+		return textNode{fields: textFields, text: strings.ToLower(tok.value)}, nil
+
+	case tokenWord:
+		if tok.value == "AND" || tok.value == "OR" {
+			return nil, &ParseError{Pos: tok.pos, Msg: fmt.Sprintf("%s needs a term before it", tok.value)}
+		}
+
+		if p.peek().kind == tokenColon {
+			p.next()
+			return p.parseField(tok)
+		}
+
+		if status, ok := statusKeywords[strings.ToLower(tok.value)]; ok {
+			return statusNode{status: status}, nil
+		}
+
+		return textNode{fields: textFields, text: strings.ToLower(tok.value)}, nil
+
+	case tokenColon:
+		return nil, &ParseError{Pos: tok.pos, Msg: `":" needs a field name before it`}
+	}
+
+	return nil, &ParseError{Pos: tok.pos, Msg: fmt.Sprintf("expected a search term but found %s", tok)}
+}
+
+func (p *queryParser) parseField(field token) (queryNode, error) {
+	value := p.next()
+	if value.kind != tokenWord && value.kind != tokenQuoted {
+		return nil, &ParseError{Pos: value.pos, Msg: fmt.Sprintf("%s: needs a value", field.value)}
+	}
+
+	switch strings.ToLower(field.value) {
+	case "summary":
+		return textNode{fields: []textField{fieldSummary}, text: strings.ToLower(value.value)}, nil
+
+	case "notes":
+		return textNode{fields: []textField{fieldNotes}, text: strings.ToLower(value.value)}, nil
+
+	case "area", "focus":
+		return textNode{fields: []textField{fieldFocusArea}, text: strings.ToLower(value.value)}, nil
+
+	case "tag":
+		tag := tags.Normalize(value.value)
+		if tag == "" {
+			return nil, &ParseError{Pos: value.pos, Msg: "tag: needs a tag name"}
+		}
+
+		return tagNode{tag: tag}, nil
+
+	case "id":
+		return parseIDComparison(value)
+
+	case "is":
+		if status, ok := statusKeywords[strings.ToLower(value.value)]; ok {
+			return statusNode{status: status}, nil
+		}
+
+		return nil, &ParseError{Pos: value.pos, Msg: fmt.Sprintf("unknown status %q, expected open or resolved", value.value)}
+	}
+
+	return nil, &ParseError{
+		Pos: field.pos,
+		Msg: fmt.Sprintf("unknown field %q, expected summary, notes, area, tag, id or is", field.value),
+	}
+}
+
+func parseIDComparison(value token) (queryNode, error) {
+	raw := value.value
+
+	op := "="
+	for _, candidate := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(raw, candidate) {
+			op = candidate
+			raw = raw[len(candidate):]
+			break
+		}
+	}
+
+	id, err := strconv.ParseUint(raw, 10, 0)
+	if err != nil {
+		return nil, &ParseError{Pos: value.pos, Msg: fmt.Sprintf("id: expects a number such as 42 or >100, got %q", value.value)}
+	}
+
+	return idNode{op: op, id: uint(id)}, nil
+}
@@ -0,0 +1,172 @@
+package taskquery
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+func TestParseQuery(t *testing.T) {
+	text := func(value string) queryNode {
+		return textNode{fields: textFields, text: value}
+	}
+
+	tests := []struct {
+		query string
+		want  queryNode
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"Deploy", text("deploy")},
+
+		// AND binds tighter than OR, and both group from the left.
+		{"a b", andNode{text("a"), text("b")}},
+		{"a AND b", andNode{text("a"), text("b")}},
+		{"a b c", andNode{andNode{text("a"), text("b")}, text("c")}},
+		{"a b OR c", orNode{andNode{text("a"), text("b")}, text("c")}},
+		{"a OR b c", orNode{text("a"), andNode{text("b"), text("c")}}},
+		{"a OR b OR c", orNode{orNode{text("a"), text("b")}, text("c")}},
+		{"-a b", andNode{notNode{text("a")}, text("b")}},
+		{"NOT a OR b", orNode{notNode{text("a")}, text("b")}},
+		{"NOT -a", notNode{notNode{text("a")}}},
+		{"--a", notNode{text("-a")}},
+		{"or and not", andNode{andNode{text("or"), text("and")}, text("not")}},
+
+		// Parentheses override precedence.
+		{"(a OR b) c", andNode{orNode{text("a"), text("b")}, text("c")}},
+		{"a (b OR c)", andNode{text("a"), orNode{text("b"), text("c")}}},
+		{"-(a OR b)", notNode{orNode{text("a"), text("b")}}},
+		{"((a))", text("a")},
+
+		// Quoted text is searched as it is, keywords included.
+		{`"Deploy now"`, text("deploy now")},
+		{`"OR"`, text("or")},
+		{`"open"`, text("open")},
+		{`-"a b"`, notNode{text("a b")}},
+		{`a"b"`, andNode{text("a"), text("b")}},
+		{"re-open", text("re-open")},
+
+		{"open", statusNode{status: TaskStatusOpen}},
+		{"Resolved", statusNode{status: TaskStatusResolved}},
+		{"is:OPEN", statusNode{status: TaskStatusOpen}},
+		{"summary:Deploy", textNode{fields: []textField{fieldSummary}, text: "deploy"}},
+		{`notes:"next week"`, textNode{fields: []textField{fieldNotes}, text: "next week"}},
+		{"area:work focus:home", andNode{
+			textNode{fields: []textField{fieldFocusArea}, text: "work"},
+			textNode{fields: []textField{fieldFocusArea}, text: "home"},
+		}},
+		{"tag:#Quick", tagNode{tag: "quick"}},
+		{`tag:"Next Week"`, tagNode{tag: "next-week"}},
+		{"id:42", idNode{op: "=", id: 42}},
+		{"id:>=10 id:<20", andNode{idNode{op: ">=", id: 10}, idNode{op: "<", id: 20}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q) returned error: %v", tt.query, err)
+			}
+
+			if !reflect.DeepEqual(query.root, tt.want) {
+				t.Errorf("ParseQuery(%q) = %#v, want %#v", tt.query, query.root, tt.want)
+			}
+
+			if query.String() != tt.query {
+				t.Errorf("String() = %q, want %q", query.String(), tt.query)
+			}
+		})
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		pos   int
+		msg   string
+	}{
+		{`"deploy`, 1, "unterminated quoted text"},
+		{`a "b`, 3, "unterminated quoted text"},
+		{`é "x`, 3, "unterminated quoted text"},
+		{"a )", 3, `unexpected ")" without a matching "("`},
+		{"(a b", 1, `missing ")" for this "("`},
+		{"a (b (c)", 3, `missing ")" for this "("`},
+		{"()", 1, "empty parentheses"},
+		{"a OR", 5, "expected a search term but found end of query"},
+		{"-", 2, "expected a search term but found end of query"},
+		{"a -)", 4, `expected a search term but found ")"`},
+		{"OR a", 1, "OR needs a term before it"},
+		{"a AND OR b", 7, "OR needs a term before it"},
+		{":x", 1, `":" needs a field name before it`},
+		{"summary:", 9, "summary: needs a value"},
+		{"summary:(a)", 9, "summary: needs a value"},
+		{"due:today", 1, `unknown field "due", expected summary, notes, area, tag, id or is`},
+		{"a id:abc", 6, `id: expects a number such as 42 or >100, got "abc"`},
+		{"id:>", 4, `id: expects a number such as 42 or >100, got ">"`},
+		{"is:done", 4, `unknown status "done", expected open or resolved`},
+		{"tag:#", 5, "tag: needs a tag name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := ParseQuery(tt.query)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseQuery(%q) returned %v, want a ParseError", tt.query, err)
+			}
+
+			if parseErr.Pos != tt.pos || parseErr.Msg != tt.msg {
+				t.Errorf("ParseQuery(%q) error = column %d: %s, want column %d: %s", tt.query, parseErr.Pos, parseErr.Msg, tt.pos, tt.msg)
+			}
+		})
+	}
+}
+
+func TestQueryMatch(t *testing.T) {
+	task := soqapi.TaskDTO{
+		ID:        42,
+		Summary:   "Deploy the API",
+		Notes:     "Ask ops first",
+		Status:    TaskStatusOpen,
+		FocusArea: soqapi.FocusAreaDTO{Name: "Work"},
+	}
+	meta := store.TaskMeta{Tags: []string{"quick"}}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"deploy", true},
+		{"OPS", true},
+		{"work", true},
+		{"summary:ops", false},
+		{"notes:ops", true},
+		{`"the api"`, true},
+		{`"api the"`, false},
+		{"deploy resolved", false},
+		{"deploy OR resolved", true},
+		{"resolved OR missing", false},
+		{"-resolved", true},
+		{"NOT (deploy OR missing)", false},
+		{"tag:quick id:>=42", true},
+		{"tag:slow OR id:<42", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q) returned error: %v", tt.query, err)
+			}
+
+			if got := query.Match(task, meta); got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
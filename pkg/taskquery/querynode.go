@@ -0,0 +1,113 @@
+package taskquery
+
+import (
+	"slices"
+	"strings"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+type textField int
+
+const (
+	fieldSummary textField = iota
+	fieldNotes
+	fieldFocusArea
+)
+
+// textFields are searched by terms without a field name.
+var textFields = []textField{fieldSummary, fieldNotes, fieldFocusArea}
+
+type queryNode interface {
+	match(task soqapi.TaskDTO, meta store.TaskMeta) bool
+}
+
+type andNode struct {
+	left, right queryNode
+}
+
+func (n andNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	return n.left.match(task, meta) && n.right.match(task, meta)
+}
+
+type orNode struct {
+	left, right queryNode
+}
+
+func (n orNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	return n.left.match(task, meta) || n.right.match(task, meta)
+}
+
+type notNode struct {
+	operand queryNode
+}
+
+func (n notNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	return !n.operand.match(task, meta)
+}
+
+// textNode matches a case-insensitive substring of any of its fields. text is
+// already lowercase.
+type textNode struct {
+	fields []textField
+	text   string
+}
+
+func (n textNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	for _, field := range n.fields {
+		var value string
+
+		switch field {
+		case fieldSummary:
+			value = task.Summary
+		case fieldNotes:
+			value = task.Notes
+		case fieldFocusArea:
+			value = task.FocusArea.Name
+		}
+
+		if strings.Contains(strings.ToLower(value), n.text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type statusNode struct {
+	status int
+}
+
+func (n statusNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	return task.Status == n.status
+}
+
+type idNode struct {
+	op string
+	id uint
+}
+
+func (n idNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	switch n.op {
+	case ">":
+		return task.ID > n.id
+	case ">=":
+		return task.ID >= n.id
+	case "<":
+		return task.ID < n.id
+	case "<=":
+		return task.ID <= n.id
+	}
+
+	return task.ID == n.id
+}
+
+// tagNode matches tasks carrying a tag. tag is already normalized.
+type tagNode struct {
+	tag string
+}
+
+func (n tagNode) match(task soqapi.TaskDTO, meta store.TaskMeta) bool {
+	return slices.Contains(meta.Tags, n.tag)
+}
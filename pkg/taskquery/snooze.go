@@ -0,0 +1,21 @@
+package taskquery
+
+import (
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// WithoutSnoozed returns the tasks that are not snoozed at now.
+func WithoutSnoozed(tasks []soqapi.TaskDTO, meta map[uint]store.TaskMeta, now time.Time) []soqapi.TaskDTO {
+	awake := make([]soqapi.TaskDTO, 0, len(tasks))
+
+	for _, task := range tasks {
+		if !meta[task.ID].IsSnoozed(now) {
+			awake = append(awake, task)
+		}
+	}
+
+	return awake
+}
@@ -0,0 +1,32 @@
+package taskquery
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+func TestWithoutSnoozed(t *testing.T) {
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	tasks := []soqapi.TaskDTO{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+	meta := map[uint]store.TaskMeta{
+		1: {SnoozedUntil: &later},
+		2: {SnoozedUntil: &earlier},
+		3: {SnoozedUntil: &now},
+	}
+
+	var got []uint
+	for _, task := range WithoutSnoozed(tasks, meta, now) {
+		got = append(got, task.ID)
+	}
+
+	if want := []uint{2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("WithoutSnoozed = %v, want %v", got, want)
+	}
+}
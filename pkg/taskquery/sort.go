@@ -0,0 +1,159 @@
+package taskquery
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+	"strings"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+type SortField string
+
+const (
+	// SortDefault keeps the order the API returns tasks in.
+	SortDefault SortField = ""
+
+	// SortManual follows the order the user arranged tasks in. Tasks that
+	// were never arranged come last.
+	SortManual    SortField = "manual"
+	SortPriority  SortField = "priority"
+	SortDue       SortField = "due"
+	SortSummary   SortField = "summary"
+	SortFocusArea SortField = "focusArea"
+	SortCreated   SortField = "created"
+	SortUpdated   SortField = "updated"
+	SortID        SortField = "id"
+)
+
+// SortFields lists the sort fields in the order the task list cycles through
+// them.
+var SortFields = []SortField{
+	SortDefault,
+	SortManual,
+	SortPriority,
+	SortDue,
+	SortSummary,
+	SortFocusArea,
+	SortCreated,
+	SortUpdated,
+	SortID,
+}
+
+// Sort is a sort field and direction.
+type Sort struct {
+	Field      SortField
+	Descending bool
+}
+
+func ParseSortField(raw string) (SortField, error) {
+	for _, field := range SortFields {
+		if strings.EqualFold(string(field), raw) {
+			return field, nil
+		}
+	}
+
+	names := make([]string, 0, len(SortFields))
+	for _, field := range SortFields[1:] {
+		names = append(names, string(field))
+	}
+
+	return SortDefault, fmt.Errorf("unknown sort field %q, expected one of %s", raw, strings.Join(names, ", "))
+}
+
+// Next returns the sort field after f, wrapping back to SortDefault.
+func (f SortField) Next() SortField {
+	for idx, field := range SortFields {
+		if field == f {
+			return SortFields[(idx+1)%len(SortFields)]
+		}
+	}
+
+	return SortDefault
+}
+
+func (s Sort) String() string {
+	if s.Field == SortDefault {
+		return "default order"
+	}
+
+	arrow := "↑"
+	if s.Descending {
+		arrow = "↓"
+	}
+
+	return fmt.Sprintf("%s %s", s.Field, arrow)
+}
+
+// SortTasks returns a sorted copy of tasks. Created and updated times and
+// priorities come from the local task metadata; tasks without them sort first,
+// except that priority sorts the most urgent first and due dates the soonest
+// first. manual is the arranged
+// order of task IDs. Ties keep their original order.
+func SortTasks(tasks []soqapi.TaskDTO, order Sort, meta map[uint]store.TaskMeta, manual []uint) []soqapi.TaskDTO {
+	sorted := make([]soqapi.TaskDTO, len(tasks))
+	copy(sorted, tasks)
+
+	if order.Field == SortDefault {
+		return sorted
+	}
+
+	ranks := make(map[uint]int, len(manual))
+	for idx, taskID := range manual {
+		ranks[taskID] = idx
+	}
+
+	rank := func(taskID uint) int {
+		if idx, ok := ranks[taskID]; ok {
+			return idx
+		}
+
+		return len(manual)
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		var result int
+		if order.Field == SortManual {
+			result = cmp.Compare(rank(sorted[i].ID), rank(sorted[j].ID))
+		} else {
+			result = compareTasks(sorted[i], sorted[j], order.Field, meta)
+		}
+
+		if order.Descending {
+			return result > 0
+		}
+
+		return result < 0
+	})
+
+	return sorted
+}
+
+func compareTasks(a, b soqapi.TaskDTO, field SortField, meta map[uint]store.TaskMeta) int {
+	switch field {
+	case SortPriority:
+		return cmp.Compare(meta[b.ID].Priority, meta[a.ID].Priority)
+
+	case SortDue:
+		return compareDue(meta[a.ID].Due, meta[b.ID].Due)
+
+	case SortSummary:
+		return strings.Compare(strings.ToLower(a.Summary), strings.ToLower(b.Summary))
+
+	case SortFocusArea:
+		return strings.Compare(strings.ToLower(a.FocusArea.Name), strings.ToLower(b.FocusArea.Name))
+
+	case SortCreated:
+		return meta[a.ID].CreatedAt.Compare(meta[b.ID].CreatedAt)
+
+	case SortUpdated:
+		return meta[a.ID].UpdatedAt.Compare(meta[b.ID].UpdatedAt)
+
+	case SortID:
+		return cmp.Compare(a.ID, b.ID)
+	}
+
+	return 0
+}
@@ -0,0 +1,36 @@
+package taskquery
+
+import (
+	"slices"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// FilterByTags returns the tasks that carry every one of the given tags, or
+// every task without tags to filter by.
+func FilterByTags(tasks []soqapi.TaskDTO, tags []string, meta map[uint]store.TaskMeta) []soqapi.TaskDTO {
+	if len(tags) == 0 {
+		return tasks
+	}
+
+	filtered := make([]soqapi.TaskDTO, 0, len(tasks))
+
+	for _, task := range tasks {
+		if hasTags(meta[task.ID].Tags, tags) {
+			filtered = append(filtered, task)
+		}
+	}
+
+	return filtered
+}
+
+func hasTags(taskTags []string, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(taskTags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
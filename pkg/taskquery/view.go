@@ -0,0 +1,60 @@
+package taskquery
+
+import (
+	"fmt"
+	"strings"
+
+	soqapi "github.com/mole-squad/soq-api/api"
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// View is a saved view with its focus area resolved.
+type View struct {
+	Name        string
+	FocusAreaID uint
+	Query       Query
+	Sort        Sort
+	Group       bool
+}
+
+// FindView looks up a saved view by case-insensitive name.
+func FindView(views []store.SavedView, name string) (store.SavedView, error) {
+	for _, view := range views {
+		if strings.EqualFold(view.Name, name) {
+			return view, nil
+		}
+	}
+
+	return store.SavedView{}, fmt.Errorf("no saved view named %q", name)
+}
+
+// ResolveView checks a saved view and looks up its focus area.
+func ResolveView(saved store.SavedView, focusAreas []soqapi.FocusAreaDTO) (View, error) {
+	view := View{
+		Name:  saved.Name,
+		Group: saved.Group,
+	}
+
+	field, err := ParseSortField(saved.Sort)
+	if err != nil {
+		return view, fmt.Errorf("error in view %q: %w", saved.Name, err)
+	}
+
+	view.Sort = Sort{Field: field, Descending: saved.Descending}
+
+	view.Query, err = ParseQuery(saved.Query)
+	if err != nil {
+		return view, fmt.Errorf("error in view %q query: %w", saved.Name, err)
+	}
+
+	if saved.FocusArea != "" {
+		focusArea, err := FindFocusArea(focusAreas, saved.FocusArea)
+		if err != nil {
+			return view, fmt.Errorf("error in view %q: %w", saved.Name, err)
+		}
+
+		view.FocusAreaID = focusArea.ID
+	}
+
+	return view, nil
+}
@@ -0,0 +1,182 @@
+package timeentryform
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/entityform"
+	"github.com/mole-squad/soq-tui/pkg/forms"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+	"github.com/mole-squad/soq-tui/pkg/utils"
+)
+
+const (
+	timeEntryFormID = "timeentryform"
+	startFieldID    = "start"
+	durationFieldID = "duration"
+
+	startFormat = "2006-01-02 15:04"
+)
+
+var schema = forms.NewSchema(
+	timeEntryFormID,
+	forms.TextField(
+		startFieldID,
+		"Start",
+		forms.WithHelp("Local time as "+startFormat),
+		forms.WithValidators(forms.Required(), pastTime()),
+	),
+	forms.TextField(
+		durationFieldID,
+		"Duration",
+		forms.WithHelp("Hours and minutes such as 1:30, or 1h30m"),
+		forms.WithValidators(forms.Required(), positiveDuration()),
+	),
+)
+
+// timeEntryEntity is the editable view of a stopped time entry.
+type timeEntryEntity struct {
+	ID       uint   `form:"-"`
+	Start    string `form:"start"`
+	Duration string `form:"duration"`
+}
+
+type Model struct {
+	client *api.Client
+	logger *logger.Logger
+	store  *store.Store
+
+	form entityform.Model[timeEntryEntity]
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	model := Model{
+		client: client,
+		logger: logger,
+		store:  store,
+	}
+
+	model.form = entityform.New(
+		schema,
+		common.AppStateTimeLog,
+		model.saveTimeEntry,
+		forms.WithDrafts(store, logger),
+	)
+
+	return model
+}
+
+func (m Model) Init() tea.Cmd {
+	return m.form.Init()
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	if msg, ok := msg.(common.EditTimeEntryMsg); ok {
+		m.form, cmd = m.form.Edit(timeEntryEntity{
+			ID:       msg.Entry.ID,
+			Start:    msg.Entry.Start.Local().Format(startFormat),
+			Duration: timetracking.FormatDuration(msg.Entry.Duration(time.Now())),
+		})
+
+		return m, cmd
+	}
+
+	m.form, cmd = utils.ApplyUpdate(m.form, msg)
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	return m.form.View()
+}
+
+func (m Model) Blur() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+func (m Model) Focus() (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.form, cmd = utils.ApplyFocus(m.form)
+
+	return m, cmd
+}
+
+// saveTimeEntry applies the edited times to the entry as it is in the log, so
+// the task details recorded with it are kept.
+func (m Model) saveTimeEntry(edited timeEntryEntity, isNew bool) error {
+	entries, err := m.store.LoadTimeEntries()
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(entries, func(entry store.TimeEntry) bool {
+		return entry.ID == edited.ID
+	})
+	if idx < 0 {
+		return fmt.Errorf("time entry %d no longer exists", edited.ID)
+	}
+
+	start, err := time.ParseInLocation(startFormat, edited.Start, time.Local)
+	if err != nil {
+		return fmt.Errorf("error reading start time: %w", err)
+	}
+
+	duration, err := timetracking.ParseDuration(edited.Duration)
+	if err != nil {
+		return err
+	}
+
+	entry := entries[idx]
+	entry.Start = start
+	entry.End = start.Add(duration)
+
+	return m.store.UpdateTimeEntry(entry)
+}
+
+func pastTime() forms.Validator {
+	return func(value any) error {
+		text, _ := value.(string)
+		if text == "" {
+			return nil
+		}
+
+		start, err := time.ParseInLocation(startFormat, text, time.Local)
+		if err != nil {
+			return fmt.Errorf("must be a time such as %s", time.Now().Format(startFormat))
+		}
+
+		if start.After(time.Now()) {
+			return fmt.Errorf("must not be in the future")
+		}
+
+		return nil
+	}
+}
+
+func positiveDuration() forms.Validator {
+	return func(value any) error {
+		text, _ := value.(string)
+		if text == "" {
+			return nil
+		}
+
+		duration, err := timetracking.ParseDuration(text)
+		if err != nil {
+			return fmt.Errorf("must be hours and minutes such as 1:30, or 1h30m")
+		}
+
+		if duration <= 0 {
+			return fmt.Errorf("must be longer than zero")
+		}
+
+		return nil
+	}
+}
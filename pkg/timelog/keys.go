@@ -0,0 +1,38 @@
+package timelog
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type keyMap struct {
+	Back   key.Binding
+	Edit   key.Binding
+	Delete key.Binding
+	Stop   key.Binding
+	Undo   key.Binding
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e", "enter"),
+			key.WithHelp("e", "edit entry"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "delete entry"),
+		),
+		Stop: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "stop tracking"),
+		),
+		Undo: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "undo"),
+		),
+	}
+}
@@ -0,0 +1,302 @@
+package timelog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/api"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/mouse"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+	"github.com/mole-squad/soq-tui/pkg/undo"
+)
+
+// Model lists the tracked time, newest first, for editing and deleting
+// entries.
+type Model struct {
+	client *api.Client
+	logger *logger.Logger
+	store  *store.Store
+
+	keys    keyMap
+	teaList list.Model
+	undo    undo.Stack
+
+	delegate mouse.ListDelegate
+	helpBar  mouse.HelpBar
+	clicks   mouse.ClickTracker
+
+	height int
+	width  int
+}
+
+func New(logger *logger.Logger, client *api.Client, store *store.Store) common.AppView {
+	listKeys := newKeyMap()
+
+	delegate := mouse.NewListDelegate(list.NewDefaultDelegate())
+
+	teaList := list.New([]list.Item{}, delegate, 0, 0)
+	teaList.Title = "Time Log"
+	teaList.SetShowHelp(false)
+	teaList.SetStatusBarItemName("entry", "entries")
+
+	teaList.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			listKeys.Back,
+			listKeys.Edit,
+			listKeys.Delete,
+			listKeys.Stop,
+		}
+	}
+
+	teaList.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			listKeys.Back,
+			listKeys.Edit,
+			listKeys.Delete,
+			listKeys.Stop,
+			listKeys.Undo,
+		}
+	}
+
+	return Model{
+		client:   client,
+		logger:   logger,
+		store:    store,
+		keys:     listKeys,
+		teaList:  teaList,
+		delegate: delegate,
+		helpBar:  mouse.NewHelpBar(),
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resizeList()
+
+	case tea.KeyMsg:
+		return m.onKeyMsg(msg)
+
+	case tea.MouseMsg:
+		return m.onMouseMsg(msg)
+	}
+
+	if undo.IsExpireMsg(msg) {
+		m.undo = m.undo.Update(msg)
+		m.resizeList()
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.teaList, cmd = m.teaList.Update(msg)
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left, m.teaList.View(), m.renderHelp())
+}
+
+func (m Model) Blur() (tea.Model, tea.Cmd) {
+	return m, nil
+}
+
+func (m Model) Focus() (tea.Model, tea.Cmd) {
+	return m.refreshEntries()
+}
+
+func (m Model) onKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.teaList.SettingFilter() {
+		var cmd tea.Cmd
+		m.teaList, cmd = m.teaList.Update(msg)
+
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		if m.teaList.IsFiltered() {
+			m.teaList.ResetFilter()
+			return m, nil
+		}
+
+		return m, common.AppStateCmd(common.AppStateTaskList)
+
+	case key.Matches(msg, m.keys.Edit):
+		return m.onEdit()
+
+	case key.Matches(msg, m.keys.Delete):
+		return m.onDelete()
+
+	case key.Matches(msg, m.keys.Stop):
+		return m.onStop()
+
+	case key.Matches(msg, m.keys.Undo):
+		return m.onUndo()
+	}
+
+	var cmd tea.Cmd
+	m.teaList, cmd = m.teaList.Update(msg)
+
+	return m, cmd
+}
+
+func (m Model) onMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if keyMsg, ok := m.helpBar.Clicked(m.teaList, msg); ok {
+		return m.onKeyMsg(keyMsg)
+	}
+
+	if m.teaList.SettingFilter() {
+		return m, nil
+	}
+
+	idx := m.delegate.HandleMouse(&m.teaList, msg)
+	if idx >= 0 && m.clicks.Click(fmt.Sprint(idx)) {
+		return m.onEdit()
+	}
+
+	return m, nil
+}
+
+func (m *Model) resizeList() {
+	m.teaList.SetSize(m.width, m.height-lipgloss.Height(m.renderHelp()))
+}
+
+func (m Model) renderHelp() string {
+	help := m.teaList.Styles.HelpStyle.Render(m.helpBar.View(m.teaList.Help, m.teaList))
+
+	if toast := m.undo.View(m.keys.Undo.Help().Key); toast != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, m.teaList.Styles.HelpStyle.Render(toast), help)
+	}
+
+	return help
+}
+
+func (m Model) refreshEntries() (Model, tea.Cmd) {
+	entries, err := m.store.LoadTimeEntries()
+	if err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	slices.Reverse(entries)
+
+	now := time.Now()
+	weekStart := timetracking.StartOfWeek(now)
+
+	var week time.Duration
+
+	items := make([]list.Item, len(entries))
+	for idx, entry := range entries {
+		items[idx] = TimeEntryItem{entry: entry}
+
+		if entry.Start.After(weekStart) {
+			week += entry.Duration(now)
+		}
+	}
+
+	m.teaList.Title = fmt.Sprintf("Time Log · %s this week", timetracking.FormatDuration(week))
+
+	return m, m.teaList.SetItems(items)
+}
+
+func (m Model) selectedEntry() (store.TimeEntry, bool) {
+	entryItem, ok := m.teaList.SelectedItem().(TimeEntryItem)
+
+	return entryItem.entry, ok
+}
+
+func (m Model) onEdit() (Model, tea.Cmd) {
+	entry, ok := m.selectedEntry()
+	if !ok {
+		return m, nil
+	}
+
+	if entry.IsRunning() {
+		return m, m.teaList.NewStatusMessage("Stop tracking before editing this entry")
+	}
+
+	return m, tea.Sequence(
+		common.NewEditTimeEntryMsg(entry),
+		common.AppStateCmd(common.AppStateTimeEntryForm),
+	)
+}
+
+func (m Model) onDelete() (Model, tea.Cmd) {
+	entry, ok := m.selectedEntry()
+	if !ok {
+		return m, nil
+	}
+
+	if err := m.store.DeleteTimeEntry(entry.ID); err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	var undoCmd tea.Cmd
+	m.undo, undoCmd = m.undo.Push(
+		fmt.Sprintf("Deleted %s on %q", timetracking.FormatDuration(entry.Duration(time.Now())), entry.Summary),
+		func(ctx context.Context) error {
+			return m.store.UpdateTimeEntry(entry)
+		},
+	)
+
+	m, refreshCmd := m.refreshEntries()
+	m.resizeList()
+
+	return m, tea.Batch(refreshCmd, undoCmd, common.NewTimeLogChangedMsg())
+}
+
+func (m Model) onStop() (Model, tea.Cmd) {
+	entry, ok, err := m.store.StopTimeEntry(time.Now())
+	if err != nil {
+		return m, common.NewErrorMsg(err)
+	}
+
+	if !ok {
+		return m, m.teaList.NewStatusMessage("No timer is running")
+	}
+
+	m, refreshCmd := m.refreshEntries()
+
+	return m, tea.Batch(
+		refreshCmd,
+		common.NewTimeLogChangedMsg(),
+		m.teaList.NewStatusMessage(fmt.Sprintf("Stopped tracking %q", entry.Summary)),
+	)
+}
+
+func (m Model) onUndo() (Model, tea.Cmd) {
+	var (
+		undoFunc undo.Func
+		ok       bool
+	)
+
+	m.undo, undoFunc, ok = m.undo.Pop()
+	if !ok {
+		return m, nil
+	}
+
+	if err := undoFunc(context.Background()); err != nil {
+		return m, common.NewErrorMsg(fmt.Errorf("failed to undo: %w", err))
+	}
+
+	m, cmd := m.refreshEntries()
+	m.resizeList()
+
+	return m, tea.Batch(cmd, common.NewTimeLogChangedMsg())
+}
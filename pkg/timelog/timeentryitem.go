@@ -0,0 +1,47 @@
+package timelog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+)
+
+const (
+	entryDayFormat  = "Mon Jan 2"
+	entryTimeFormat = "15:04"
+)
+
+type TimeEntryItem struct {
+	entry store.TimeEntry
+}
+
+func (t TimeEntryItem) Title() string {
+	return fmt.Sprintf("#%d %s", t.entry.TaskID, t.entry.Summary)
+}
+
+func (t TimeEntryItem) Description() string {
+	start := t.entry.Start.Local()
+
+	span := fmt.Sprintf("%s %s–%s", start.Format(entryDayFormat), start.Format(entryTimeFormat), t.entry.End.Local().Format(entryTimeFormat))
+	if t.entry.IsRunning() {
+		span = fmt.Sprintf("%s since %s", start.Format(entryDayFormat), start.Format(entryTimeFormat))
+	}
+
+	description := span + " · " + timetracking.FormatDuration(t.entry.Duration(time.Now()))
+
+	if t.entry.IsRunning() {
+		description += " · running"
+	}
+
+	if t.entry.FocusArea != "" {
+		description += " · " + t.entry.FocusArea
+	}
+
+	return description
+}
+
+func (t TimeEntryItem) FilterValue() string {
+	return t.entry.Summary + " " + t.entry.FocusArea
+}
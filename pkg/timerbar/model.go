@@ -0,0 +1,144 @@
+package timerbar
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/common"
+	"github.com/mole-squad/soq-tui/pkg/logger"
+	"github.com/mole-squad/soq-tui/pkg/store"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+	"github.com/mole-squad/soq-tui/pkg/timetracking"
+)
+
+// tickMsg carries the ID of the timer that scheduled it, so ticks for a
+// stopped timer are dropped.
+type tickMsg struct {
+	id int64
+}
+
+var lastTickID atomic.Int64
+
+var (
+	barStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Background(styles.HotPink).
+			Padding(0, 1)
+
+	elapsedStyle = lipgloss.NewStyle().Bold(true)
+)
+
+// Model is a status bar showing the running time tracking entry. It is empty
+// when no timer runs.
+type Model struct {
+	logger *logger.Logger
+	store  *store.Store
+
+	active  store.TimeEntry
+	running bool
+	tickID  int64
+
+	width int
+}
+
+func New(logger *logger.Logger, store *store.Store) Model {
+	return Model{
+		logger: logger,
+		store:  store,
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return common.NewTimeLogChangedMsg()
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case common.TimeLogChangedMsg:
+		return m.reload()
+
+	case tickMsg:
+		if !m.running || msg.id != m.tickID {
+			return m, nil
+		}
+
+		return m, tick(m.tickID)
+	}
+
+	return m, nil
+}
+
+// IsVisible reports whether the bar takes up a line.
+func (m Model) IsVisible() bool {
+	return m.running
+}
+
+func (m Model) SetWidth(width int) Model {
+	m.width = width
+
+	return m
+}
+
+func (m Model) View() string {
+	if !m.running {
+		return ""
+	}
+
+	elapsed := "● " + timetracking.FormatElapsed(m.active.Duration(time.Now())) + "  "
+
+	label := fmt.Sprintf("#%d %s", m.active.TaskID, m.active.Summary)
+	if m.active.FocusArea != "" {
+		label += " · " + m.active.FocusArea
+	}
+
+	labelWidth := m.width - barStyle.GetHorizontalFrameSize() - lipgloss.Width(elapsed)
+
+	return barStyle.Width(m.width).Render(elapsedStyle.Render(elapsed) + truncate(label, labelWidth))
+}
+
+func (m Model) reload() (Model, tea.Cmd) {
+	entries, err := m.store.LoadTimeEntries()
+	if err != nil {
+		m.logger.Error("Error loading time log", "error", err)
+	}
+
+	active, running := store.ActiveTimeEntry(entries)
+
+	wasRunning := m.running
+	m.active = active
+	m.running = running
+
+	if !running || wasRunning {
+		return m, nil
+	}
+
+	m.tickID = lastTickID.Add(1)
+
+	return m, tick(m.tickID)
+}
+
+func tick(id int64) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return tickMsg{id: id}
+	})
+}
+
+func truncate(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	if lipgloss.Width(text) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes) + "…"
+}
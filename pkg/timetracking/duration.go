@@ -0,0 +1,101 @@
+package timetracking
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mole-squad/soq-tui/pkg/recurrence"
+)
+
+// FormatDuration renders a duration as hours and minutes, such as 1:05.
+func FormatDuration(d time.Duration) string {
+	minutes := int(max(d, 0) / time.Minute)
+
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+// FormatElapsed renders a duration with seconds, such as 1:05:09, for a
+// running timer.
+func FormatElapsed(d time.Duration) string {
+	seconds := int(max(d, 0) / time.Second)
+
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// ParseDuration reads a duration written as hours and minutes (1:30) or with
+// units (1h30m, 45m).
+func ParseDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	if hours, minutes, ok := strings.Cut(value, ":"); ok {
+		h, hErr := strconv.Atoi(hours)
+		m, mErr := strconv.Atoi(minutes)
+
+		if hErr != nil || mErr != nil || h < 0 || m < 0 || m > 59 {
+			return 0, fmt.Errorf("invalid duration %q, expected h:mm such as 1:30", value)
+		}
+
+		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q, expected h:mm or 1h30m", value)
+	}
+
+	return d, nil
+}
+
+// ParseSince reads the start of a report period. Days (7d) and weeks (2w)
+// count whole days, including today. Hours and minutes (12h, 90m) count back
+// from now. A date (2006-01-02) starts at its midnight.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if date, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return date, nil
+	}
+
+	if count, unit := splitCount(value); count > 0 {
+		switch unit {
+		case "d":
+			return StartOfDay(now).AddDate(0, 0, 1-count), nil
+		case "w":
+			return StartOfDay(now).AddDate(0, 0, 1-7*count), nil
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return time.Time{}, fmt.Errorf("invalid period %q, expected a number of days (7d), weeks (2w), hours (12h) or a date (2006-01-02)", value)
+	}
+
+	return now.Add(-d), nil
+}
+
+func splitCount(value string) (int, string) {
+	if len(value) < 2 {
+		return 0, ""
+	}
+
+	count, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil {
+		return 0, ""
+	}
+
+	return count, value[len(value)-1:]
+}
+
+// StartOfDay returns midnight at the start of t's day in t's location.
+func StartOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// StartOfWeek returns midnight on the Monday of t's week.
+func StartOfWeek(t time.Time) time.Time {
+	return StartOfDay(t).AddDate(0, 0, -recurrence.MondayIndex(t.Weekday()))
+}
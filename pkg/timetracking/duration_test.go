@@ -0,0 +1,115 @@
+package timetracking
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("error loading time zone: %v", err)
+	}
+
+	// A Wednesday afternoon.
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, newYork)
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"1d", at(21, 0, 0)},
+		{"7d", at(15, 0, 0)},
+		{" 7d ", at(15, 0, 0)},
+		{"1w", at(15, 0, 0)},
+		{"2w", at(8, 0, 0)},
+		{"12h", at(21, 3, 0)},
+		{"90m", at(21, 13, 30)},
+		{"1h30m", at(21, 13, 30)},
+		{"2026-10-01", at(1, 0, 0)},
+		{"4w", time.Date(2026, time.September, 24, 0, 0, 0, 0, newYork)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSince(tt.value, now)
+			if err != nil {
+				t.Fatalf("ParseSince(%q) returned error: %v", tt.value, err)
+			}
+
+			if !got.Equal(tt.want) || got.Location() != newYork {
+				t.Errorf("ParseSince(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSinceErrors(t *testing.T) {
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"", "0d", "-1d", "0w", "-2h", "0m", "7x", "d", "soon", "2026-13-01"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseSince(value, now)
+
+			want := `invalid period "` + value + `", expected a number of days (7d), weeks (2w), hours (12h) or a date (2006-01-02)`
+			if err == nil || err.Error() != want {
+				t.Errorf("ParseSince(%q) error = %v, want %q", value, err, want)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"1:30", 90 * time.Minute},
+		{"0:05", 5 * time.Minute},
+		{"12:00", 12 * time.Hour},
+		{"1h30m", 90 * time.Minute},
+		{" 45m ", 45 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDuration(tt.value)
+			if err != nil || got != tt.want {
+				t.Errorf("ParseDuration(%q) = %s, %v, want %s", tt.value, got, err, tt.want)
+			}
+		})
+	}
+
+	for _, value := range []string{"1:60", "-1:00", "1:xx", "soon"} {
+		if _, err := ParseDuration(value); err == nil {
+			t.Errorf("ParseDuration(%q) returned no error", value)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d           time.Duration
+		wantFormat  string
+		wantElapsed string
+	}{
+		{0, "0:00", "0:00:00"},
+		{-time.Minute, "0:00", "0:00:00"},
+		{65*time.Minute + 9*time.Second, "1:05", "1:05:09"},
+		{25 * time.Hour, "25:00", "25:00:00"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatDuration(tt.d); got != tt.wantFormat {
+			t.Errorf("FormatDuration(%s) = %q, want %q", tt.d, got, tt.wantFormat)
+		}
+
+		if got := FormatElapsed(tt.d); got != tt.wantElapsed {
+			t.Errorf("FormatElapsed(%s) = %q, want %q", tt.d, got, tt.wantElapsed)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package timetracking
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+// Grouping is how report totals are broken down.
+type Grouping string
+
+const (
+	ByFocusArea Grouping = "focus-area"
+	ByTask      Grouping = "task"
+)
+
+// Groupings lists the supported groupings for help text.
+var Groupings = []Grouping{ByFocusArea, ByTask}
+
+func ParseGrouping(value string) (Grouping, error) {
+	for _, grouping := range Groupings {
+		if string(grouping) == value {
+			return grouping, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown grouping %q, expected focus-area or task", value)
+}
+
+// Label names the grouping in report headers.
+func (g Grouping) Label() string {
+	if g == ByTask {
+		return "Task"
+	}
+
+	return "Focus area"
+}
+
+// Total is the time tracked in a group during one day or week.
+type Total struct {
+	// Start is midnight on the day, or on the Monday of the week.
+	Start    time.Time
+	Group    string
+	Duration time.Duration
+}
+
+// Report holds daily and weekly totals of the time tracked in a period.
+type Report struct {
+	Since  time.Time
+	Until  time.Time
+	By     Grouping
+	Daily  []Total
+	Weekly []Total
+}
+
+// BuildReport totals the entries between since and until. Entries that cross
+// midnight are split between days, and running entries count up to until.
+func BuildReport(entries []store.TimeEntry, since, until time.Time, by Grouping) Report {
+	type totalKey struct {
+		start time.Time
+		group string
+	}
+
+	daily := make(map[totalKey]time.Duration)
+	weekly := make(map[totalKey]time.Duration)
+
+	for _, entry := range entries {
+		start := entry.Start.In(since.Location())
+		end := until
+		if !entry.IsRunning() {
+			end = entry.End.In(since.Location())
+		}
+
+		if start.Before(since) {
+			start = since
+		}
+
+		if end.After(until) {
+			end = until
+		}
+
+		group := groupLabel(entry, by)
+
+		for start.Before(end) {
+			day := StartOfDay(start)
+			chunkEnd := day.AddDate(0, 0, 1)
+			if chunkEnd.After(end) {
+				chunkEnd = end
+			}
+
+			daily[totalKey{start: day, group: group}] += chunkEnd.Sub(start)
+			weekly[totalKey{start: StartOfWeek(day), group: group}] += chunkEnd.Sub(start)
+
+			start = chunkEnd
+		}
+	}
+
+	toTotals := func(totals map[totalKey]time.Duration) []Total {
+		result := make([]Total, 0, len(totals))
+		for key, duration := range totals {
+			result = append(result, Total{Start: key.start, Group: key.group, Duration: duration})
+		}
+
+		slices.SortFunc(result, func(a, b Total) int {
+			if c := a.Start.Compare(b.Start); c != 0 {
+				return c
+			}
+
+			return cmp.Compare(a.Group, b.Group)
+		})
+
+		return result
+	}
+
+	return Report{
+		Since:  since,
+		Until:  until,
+		By:     by,
+		Daily:  toTotals(daily),
+		Weekly: toTotals(weekly),
+	}
+}
+
+func groupLabel(entry store.TimeEntry, by Grouping) string {
+	if by == ByTask {
+		return fmt.Sprintf("#%d %s", entry.TaskID, entry.Summary)
+	}
+
+	if entry.FocusArea == "" {
+		return "No focus area"
+	}
+
+	return entry.FocusArea
+}
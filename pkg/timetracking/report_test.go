@@ -0,0 +1,148 @@
+package timetracking
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/mole-squad/soq-tui/pkg/store"
+)
+
+func TestBuildReport(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	entries := []store.TimeEntry{
+		// Before the period.
+		{TaskID: 1, Summary: "Write report", FocusArea: "Work", Start: at(17, 9, 0), End: at(17, 10, 0)},
+		// Starts before the period, so only the part after since counts.
+		{TaskID: 1, Summary: "Write report", FocusArea: "Work", Start: at(18, 11, 0), End: at(18, 13, 0)},
+		// Sunday, the end of the previous week.
+		{TaskID: 1, Summary: "Write report", FocusArea: "Work", Start: at(18, 22, 0), End: at(18, 23, 0)},
+		{TaskID: 1, Summary: "Write report", FocusArea: "Work", Start: at(19, 9, 0), End: at(19, 10, 30)},
+		// Crosses midnight.
+		{TaskID: 2, Summary: "Call mom", Start: at(19, 23, 30), End: at(20, 0, 45)},
+		// Running, so it counts up to until.
+		{TaskID: 3, Summary: "Deploy", FocusArea: "Work", Start: at(21, 14, 0)},
+		// After the period.
+		{TaskID: 3, Summary: "Deploy", FocusArea: "Work", Start: at(22, 9, 0), End: at(22, 10, 0)},
+	}
+
+	since := at(18, 12, 0)
+	until := at(21, 15, 0)
+
+	tests := []struct {
+		by         Grouping
+		wantDaily  []Total
+		wantWeekly []Total
+	}{
+		{
+			by: ByFocusArea,
+			wantDaily: []Total{
+				{Start: at(18, 0, 0), Group: "Work", Duration: 2 * time.Hour},
+				{Start: at(19, 0, 0), Group: "No focus area", Duration: 30 * time.Minute},
+				{Start: at(19, 0, 0), Group: "Work", Duration: 90 * time.Minute},
+				{Start: at(20, 0, 0), Group: "No focus area", Duration: 45 * time.Minute},
+				{Start: at(21, 0, 0), Group: "Work", Duration: time.Hour},
+			},
+			wantWeekly: []Total{
+				{Start: at(12, 0, 0), Group: "Work", Duration: 2 * time.Hour},
+				{Start: at(19, 0, 0), Group: "No focus area", Duration: 75 * time.Minute},
+				{Start: at(19, 0, 0), Group: "Work", Duration: 150 * time.Minute},
+			},
+		},
+		{
+			by: ByTask,
+			wantDaily: []Total{
+				{Start: at(18, 0, 0), Group: "#1 Write report", Duration: 2 * time.Hour},
+				{Start: at(19, 0, 0), Group: "#1 Write report", Duration: 90 * time.Minute},
+				{Start: at(19, 0, 0), Group: "#2 Call mom", Duration: 30 * time.Minute},
+				{Start: at(20, 0, 0), Group: "#2 Call mom", Duration: 45 * time.Minute},
+				{Start: at(21, 0, 0), Group: "#3 Deploy", Duration: time.Hour},
+			},
+			wantWeekly: []Total{
+				{Start: at(12, 0, 0), Group: "#1 Write report", Duration: 2 * time.Hour},
+				{Start: at(19, 0, 0), Group: "#1 Write report", Duration: 90 * time.Minute},
+				{Start: at(19, 0, 0), Group: "#2 Call mom", Duration: 75 * time.Minute},
+				{Start: at(19, 0, 0), Group: "#3 Deploy", Duration: time.Hour},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.by), func(t *testing.T) {
+			report := BuildReport(entries, since, until, tt.by)
+
+			if !report.Since.Equal(since) || !report.Until.Equal(until) || report.By != tt.by {
+				t.Errorf("report covers %s to %s by %s, want %s to %s by %s", report.Since, report.Until, report.By, since, until, tt.by)
+			}
+
+			checkTotals(t, "daily", report.Daily, tt.wantDaily)
+			checkTotals(t, "weekly", report.Weekly, tt.wantWeekly)
+		})
+	}
+}
+
+// TestBuildReportLocation checks that days are split at midnight where the
+// report is read, not where the entries were recorded.
+func TestBuildReportLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("error loading time zone: %v", err)
+	}
+
+	entries := []store.TimeEntry{{
+		FocusArea: "Work",
+		Start:     time.Date(2026, time.October, 20, 2, 0, 0, 0, time.UTC),
+		End:       time.Date(2026, time.October, 20, 5, 0, 0, 0, time.UTC),
+	}}
+
+	since := time.Date(2026, time.October, 19, 0, 0, 0, 0, newYork)
+	until := time.Date(2026, time.October, 21, 0, 0, 0, 0, newYork)
+
+	report := BuildReport(entries, since, until, ByFocusArea)
+
+	checkTotals(t, "daily", report.Daily, []Total{
+		{Start: since, Group: "Work", Duration: 2 * time.Hour},
+		{Start: since.AddDate(0, 0, 1), Group: "Work", Duration: time.Hour},
+	})
+}
+
+func TestBuildReportEmpty(t *testing.T) {
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+
+	report := BuildReport(nil, now.AddDate(0, 0, -7), now, ByTask)
+	if len(report.Daily) != 0 || len(report.Weekly) != 0 {
+		t.Errorf("BuildReport(nil) = %+v, want no totals", report)
+	}
+}
+
+func TestParseGrouping(t *testing.T) {
+	for _, grouping := range Groupings {
+		if got, err := ParseGrouping(string(grouping)); err != nil || got != grouping {
+			t.Errorf("ParseGrouping(%q) = %q, %v", grouping, got, err)
+		}
+	}
+
+	_, err := ParseGrouping("day")
+	if want := `unknown grouping "day", expected focus-area or task`; err == nil || err.Error() != want {
+		t.Errorf("ParseGrouping(%q) error = %v, want %q", "day", err, want)
+	}
+}
+
+func checkTotals(t *testing.T, name string, got, want []Total) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s totals = %+v, want %+v", name, got, want)
+	}
+
+	for idx := range want {
+		if !got[idx].Start.Equal(want[idx].Start) || got[idx].Group != want[idx].Group || got[idx].Duration != want[idx].Duration {
+			t.Errorf("%s[%d] = %s %q %s, want %s %q %s", name, idx,
+				got[idx].Start, got[idx].Group, got[idx].Duration,
+				want[idx].Start, want[idx].Group, want[idx].Duration)
+		}
+	}
+}
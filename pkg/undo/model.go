@@ -0,0 +1,105 @@
+package undo
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mole-squad/soq-tui/pkg/styles"
+)
+
+// Window is how long an action can be undone after it happens.
+const Window = 8 * time.Second
+
+// Func reverses an action.
+type Func func(ctx context.Context) error
+
+type entry struct {
+	id      uint64
+	message string
+	undo    Func
+}
+
+// expireMsg ends the undo window of an entry. Entry IDs are unique across
+// stacks, so every view can pass the message to its own stack.
+type expireMsg struct {
+	id uint64
+}
+
+var lastEntryID atomic.Uint64
+
+var toastStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#FFFFFF")).
+	Background(styles.DarkGray).
+	Padding(0, 1)
+
+// Stack holds the actions that can still be undone, newest last. The newest is
+// shown as a toast.
+type Stack struct {
+	entries []entry
+}
+
+// Push records an action and starts its undo window.
+func (s Stack) Push(message string, undo Func) (Stack, tea.Cmd) {
+	id := lastEntryID.Add(1)
+
+	s.entries = append(s.entries, entry{id: id, message: message, undo: undo})
+
+	return s, tea.Tick(Window, func(time.Time) tea.Msg {
+		return expireMsg{id: id}
+	})
+}
+
+// Pop removes the newest action and returns its undo.
+func (s Stack) Pop() (Stack, Func, bool) {
+	if len(s.entries) == 0 {
+		return s, nil, false
+	}
+
+	last := s.entries[len(s.entries)-1]
+	s.entries = slices.Clip(s.entries[:len(s.entries)-1])
+
+	return s, last.undo, true
+}
+
+func (s Stack) IsEmpty() bool {
+	return len(s.entries) == 0
+}
+
+// Update drops actions whose undo window has ended.
+func (s Stack) Update(msg tea.Msg) Stack {
+	expired, ok := msg.(expireMsg)
+	if !ok {
+		return s
+	}
+
+	entries := make([]entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		if e.id != expired.id {
+			entries = append(entries, e)
+		}
+	}
+
+	s.entries = entries
+
+	return s
+}
+
+// View renders the toast for the newest action, or nothing.
+func (s Stack) View(undoKey string) string {
+	if len(s.entries) == 0 {
+		return ""
+	}
+
+	return toastStyle.Render(s.entries[len(s.entries)-1].message + " — press " + undoKey + " to undo")
+}
+
+// IsExpireMsg reports whether msg ends an undo window, so views can re-layout
+// when a toast disappears.
+func IsExpireMsg(msg tea.Msg) bool {
+	_, ok := msg.(expireMsg)
+	return ok
+}
@@ -0,0 +1,20 @@
+package utils
+
+import tea "github.com/charmbracelet/bubbletea"
+
+type Focuser interface {
+	Focus() (tea.Model, tea.Cmd)
+	Blur() (tea.Model, tea.Cmd)
+}
+
+func ApplyFocus[M Focuser](model M) (M, tea.Cmd) {
+	focusedModel, cmd := model.Focus()
+
+	return focusedModel.(M), cmd
+}
+
+func ApplyBlur[M Focuser](model M) (M, tea.Cmd) {
+	blurredModel, cmd := model.Blur()
+
+	return blurredModel.(M), cmd
+}
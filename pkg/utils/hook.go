@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// RunHook runs a user-configured command through the shell with extra
+// environment variables such as "SOQ_TASK=Buy milk".
+func RunHook(command string, env ...string) error {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Env = append(os.Environ(), env...)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("error running %q: %w: %s", command, err, out)
+	}
+
+	return nil
+}